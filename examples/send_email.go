@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"time"
 
-	"github.com/hudl/go-postmark/postmark"
+	"github.com/hudl/go-postmark/v2/postmark"
 )
 
 func main() {
@@ -17,7 +19,10 @@ func main() {
 		TextBody: postmark.String("Body"),
 	}
 
-	_, resp, err := client.Email.Send(email)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, resp, err := client.Email.Send(ctx, email)
 	if err != nil {
 		fmt.Printf("Error sending email: %+v\n", err)
 	} else {
@@ -0,0 +1,221 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"time"
+)
+
+// BounceService handles communication with the Bounce related
+// methods of the Postmark API.
+type BounceService struct {
+	client *Client
+}
+
+// Bounce represents a single bounced message.
+type Bounce struct {
+	ID            int64
+	Type          string
+	TypeCode      int
+	Name          string
+	Tag           string
+	MessageID     string
+	ServerID      int64
+	MessageStream string
+	Description   string
+	Details       string
+	Email         string
+	From          string
+	BouncedAt     *time.Time
+	DumpAvailable bool
+	Inactive      bool
+	CanActivate   bool
+	Subject       string
+
+	// Content is the raw source of the bounce. It is only included when
+	// fetching a single bounce.
+	Content string
+}
+
+// BounceList is a page of bounces returned by BounceService.List.
+type BounceList struct {
+	TotalCount int
+	Bounces    []Bounce
+}
+
+// BounceCount is the number of bounces of a single type.
+type BounceCount struct {
+	Type  string
+	Name  string
+	Count int
+}
+
+// DeliveryStats summarizes the bounces and inactive addresses of a server.
+type DeliveryStats struct {
+	InactiveMails int
+	Bounces       []BounceCount
+}
+
+// BounceListOptions specifies the optional parameters to the
+// BounceService.List method.
+type BounceListOptions struct {
+	ListOptions
+
+	// Type filters by bounce type, such as "HardBounce" or "SoftBounce".
+	Type string `url:"type,omitempty"`
+
+	// Inactive filters by whether the bounce deactivated the recipient.
+	Inactive *bool `url:"inactive,omitempty"`
+
+	// EmailFilter filters by a full or partial email address.
+	EmailFilter string `url:"emailFilter,omitempty"`
+
+	Tag       string `url:"tag,omitempty"`
+	MessageID string `url:"messageID,omitempty"`
+
+	// FromDate and ToDate filter bounces by date, in the form YYYY-MM-DD.
+	FromDate string `url:"fromdate,omitempty"`
+	ToDate   string `url:"todate,omitempty"`
+
+	MessageStream string `url:"messagestream,omitempty"`
+}
+
+// dump is the response body of the endpoints returning the raw source of a
+// message.
+type dump struct {
+	Body string
+}
+
+// bounceActivation is the response body of the bounce activation endpoint.
+type bounceActivation struct {
+	Message string
+	Bounce  *Bounce
+}
+
+// GetDeliveryStats returns a summary of inactive emails and bounces by type.
+func (s *BounceService) GetDeliveryStats(ctx context.Context) (*DeliveryStats, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "GET", "deliverystats", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats := new(DeliveryStats)
+	resp, err := s.client.Do(req, stats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stats, resp, err
+}
+
+// List returns the bounces matching the filters in opt.
+func (s *BounceService) List(ctx context.Context, opt *BounceListOptions) (*BounceList, *http.Response, error) {
+	u, err := addOptions("bounces", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bounces := new(BounceList)
+	resp, err := s.client.Do(req, bounces)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bounces, resp, err
+}
+
+// ListAll returns an iterator over all the bounces matching the filters of
+// opt, such as the bounce type or the recipient address. See Paginate.
+func (s *BounceService) ListAll(ctx context.Context, opt *BounceListOptions) iter.Seq2[Bounce, error] {
+	var base BounceListOptions
+	if opt != nil {
+		base = *opt
+	}
+
+	return Paginate(ctx, &base.ListOptions, func(ctx context.Context, page ListOptions) ([]Bounce, int, error) {
+		o := base
+		o.ListOptions = page
+
+		list, _, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Bounces, list.TotalCount, nil
+	})
+}
+
+// Get returns a single bounce.
+func (s *BounceService) Get(ctx context.Context, id int64) (*Bounce, *http.Response, error) {
+	u := fmt.Sprintf("bounces/%d", id)
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bounce := new(Bounce)
+	resp, err := s.client.Do(req, bounce)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bounce, resp, err
+}
+
+// GetDump returns the raw SMTP source of a bounce. The dump is empty if it is
+// no longer available.
+func (s *BounceService) GetDump(ctx context.Context, id int64) (string, *http.Response, error) {
+	u := fmt.Sprintf("bounces/%d/dump", id)
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	d := new(dump)
+	resp, err := s.client.Do(req, d)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return d.Body, resp, err
+}
+
+// Activate reactivates the recipient of a bounce so that it can be sent to
+// again, and returns the updated bounce.
+func (s *BounceService) Activate(ctx context.Context, id int64) (*Bounce, *http.Response, error) {
+	u := fmt.Sprintf("bounces/%d/activate", id)
+	req, err := s.client.newServerRequest(ctx, "PUT", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	activation := new(bounceActivation)
+	resp, err := s.client.Do(req, activation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return activation.Bounce, resp, err
+}
+
+// GetTags returns the tags of all messages that have bounced.
+func (s *BounceService) GetTags(ctx context.Context) ([]string, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "GET", "bounces/tags", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tags []string
+	resp, err := s.client.Do(req, &tags)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tags, resp, err
+}
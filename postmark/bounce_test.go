@@ -0,0 +1,165 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"fmt"
+	"net/http"
+)
+
+var _ = Describe("Bounce", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	Describe("Getting delivery stats", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/deliverystats", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"InactiveMails": 192,
+					"Bounces": [
+						{ "Name": "All", "Count": 253 },
+						{ "Type": "HardBounce", "Name": "Hard bounce", "Count": 195 }
+					]
+				}`)
+			})
+		})
+
+		It("should get the /deliverystats endpoint", func() {
+			_, resp, err := env.Client.Bounces.GetDeliveryStats(context.Background())
+			Expect(err).To(BeNil())
+			Expect(resp.Request.Method).To(Equal("GET"))
+			Expect(resp.Request.URL.Path).To(Equal("/deliverystats"))
+			env.assertServerHeaders(resp.Request)
+		})
+
+		It("should return the correct stats", func() {
+			stats, _, _ := env.Client.Bounces.GetDeliveryStats(context.Background())
+			Expect(stats).To(Equal(&DeliveryStats{
+				InactiveMails: 192,
+				Bounces: []BounceCount{
+					{Name: "All", Count: 253},
+					{Type: "HardBounce", Name: "Hard bounce", Count: 195},
+				},
+			}))
+		})
+	})
+
+	Describe("Listing bounces", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/bounces", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"TotalCount": 1,
+					"Bounces": [{
+						"ID": 692560173,
+						"Type": "HardBounce",
+						"TypeCode": 1,
+						"Email": "anything@blackhole.postmarkapp.com",
+						"Inactive": true
+					}]
+				}`)
+			})
+		})
+
+		It("should encode the filters as query parameters", func() {
+			_, resp, err := env.Client.Bounces.List(context.Background(), &BounceListOptions{
+				ListOptions: ListOptions{Count: 50, Offset: 100},
+				Type:        "HardBounce",
+				Inactive:    Bool(false),
+				Tag:         "Invitation",
+				FromDate:    "2014-01-01",
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Request.URL.Path).To(Equal("/bounces"))
+
+			q := resp.Request.URL.Query()
+			Expect(q.Get("count")).To(Equal("50"))
+			Expect(q.Get("offset")).To(Equal("100"))
+			Expect(q.Get("type")).To(Equal("HardBounce"))
+			Expect(q.Get("inactive")).To(Equal("false"))
+			Expect(q.Get("tag")).To(Equal("Invitation"))
+			Expect(q.Get("fromdate")).To(Equal("2014-01-01"))
+			Expect(q).NotTo(HaveKey("emailFilter"))
+		})
+
+		It("should return the correct bounces", func() {
+			bounces, _, _ := env.Client.Bounces.List(context.Background(), &BounceListOptions{})
+			Expect(bounces).To(Equal(&BounceList{
+				TotalCount: 1,
+				Bounces: []Bounce{{
+					ID:       692560173,
+					Type:     "HardBounce",
+					TypeCode: 1,
+					Email:    "anything@blackhole.postmarkapp.com",
+					Inactive: true,
+				}},
+			}))
+		})
+	})
+
+	Describe("Getting a bounce", func() {
+		It("should return the correct bounce", func() {
+			env.Mux.HandleFunc("/bounces/692560173", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				env.assertServerHeaders(r)
+				fmt.Fprintf(w, `{ "ID": 692560173, "Content": "Return-Path: <>" }`)
+			})
+
+			bounce, _, err := env.Client.Bounces.Get(context.Background(), 692560173)
+			Expect(err).To(BeNil())
+			Expect(bounce).To(Equal(&Bounce{ID: 692560173, Content: "Return-Path: <>"}))
+		})
+	})
+
+	Describe("Getting a bounce dump", func() {
+		It("should return the dump body", func() {
+			env.Mux.HandleFunc("/bounces/692560173/dump", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				fmt.Fprintf(w, `{ "Body": "SMTP dump data" }`)
+			})
+
+			dump, _, err := env.Client.Bounces.GetDump(context.Background(), 692560173)
+			Expect(err).To(BeNil())
+			Expect(dump).To(Equal("SMTP dump data"))
+		})
+	})
+
+	Describe("Activating a bounce", func() {
+		It("should put to the activate endpoint and return the bounce", func() {
+			env.Mux.HandleFunc("/bounces/692560173/activate", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PUT"))
+				env.assertServerHeaders(r)
+				fmt.Fprintf(w, `{
+					"Message": "OK",
+					"Bounce": { "ID": 692560173, "Inactive": false }
+				}`)
+			})
+
+			bounce, _, err := env.Client.Bounces.Activate(context.Background(), 692560173)
+			Expect(err).To(BeNil())
+			Expect(bounce).To(Equal(&Bounce{ID: 692560173}))
+		})
+	})
+
+	Describe("Getting bounced tags", func() {
+		It("should return the tags", func() {
+			env.Mux.HandleFunc("/bounces/tags", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `["tag1", "tag2"]`)
+			})
+
+			tags, _, err := env.Client.Bounces.GetTags(context.Background())
+			Expect(err).To(BeNil())
+			Expect(tags).To(Equal([]string{"tag1", "tag2"}))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DataRemovalService handles communication with the Data Removals related
+// methods of the Postmark API. These methods are authenticated with the
+// account token.
+type DataRemovalService struct {
+	client *Client
+}
+
+// DataRemovalStatus is the processing status of a data removal request.
+type DataRemovalStatus string
+
+// The data removal statuses reported by the Postmark API.
+const (
+	DataRemovalPending DataRemovalStatus = "Pending"
+	DataRemovalDone    DataRemovalStatus = "Done"
+)
+
+// DataRemovalRequest asks Postmark to remove all data about an email
+// address.
+type DataRemovalRequest struct {
+	// RequestedBy is the email address of the person making the request.
+	RequestedBy string `json:"RequestedBy"`
+
+	// RequestedFor is the email address whose data is removed.
+	RequestedFor string `json:"RequestedFor"`
+
+	// NotifyWhenCompleted emails RequestedBy once the removal is done.
+	NotifyWhenCompleted bool `json:"NotifyWhenCompleted"`
+}
+
+// DataRemoval is the status of a data removal request.
+type DataRemoval struct {
+	ID     int64
+	Status DataRemovalStatus
+}
+
+// Create submits a data removal request.
+func (s *DataRemovalService) Create(ctx context.Context, removal *DataRemovalRequest) (*DataRemoval, *http.Response, error) {
+	req, err := s.client.newAccountRequest(ctx, "POST", "data-removals", removal)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(DataRemoval)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+// Get returns the status of a data removal request.
+func (s *DataRemovalService) Get(ctx context.Context, id int64) (*DataRemoval, *http.Response, error) {
+	u := fmt.Sprintf("data-removals/%d", id)
+	req, err := s.client.newAccountRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(DataRemoval)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+// Wait polls the status of a data removal request every interval until it is
+// no longer pending, and returns the final status. It returns ctx.Err() if
+// ctx is done first. An interval of zero or less polls every second.
+func (s *DataRemovalService) Wait(ctx context.Context, id int64, interval time.Duration) (*DataRemoval, error) {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		removal, _, err := s.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if removal.Status != DataRemovalPending {
+			return removal, nil
+		}
+
+		timer.Reset(interval)
+	}
+}
@@ -0,0 +1,105 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+var _ = Describe("DataRemoval", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	Describe("Creating a data removal request", func() {
+		It("should post the request using the account token", func() {
+			env.Mux.HandleFunc("/data-removals", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				env.assertAccountHeaders(r)
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{
+					"RequestedBy": "privacy@example.com",
+					"RequestedFor": "john.doe@example.com",
+					"NotifyWhenCompleted": true
+				}`))
+				fmt.Fprintf(w, `{ "ID": 1234, "Status": "Pending" }`)
+			})
+
+			removal, _, err := env.Client.DataRemovals.Create(context.Background(), &DataRemovalRequest{
+				RequestedBy:         "privacy@example.com",
+				RequestedFor:        "john.doe@example.com",
+				NotifyWhenCompleted: true,
+			})
+			Expect(err).To(BeNil())
+			Expect(removal).To(Equal(&DataRemoval{ID: 1234, Status: DataRemovalPending}))
+		})
+	})
+
+	Describe("Getting a data removal status", func() {
+		It("should return the status", func() {
+			env.Mux.HandleFunc("/data-removals/1234", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				env.assertAccountHeaders(r)
+				fmt.Fprintf(w, `{ "ID": 1234, "Status": "Done" }`)
+			})
+
+			removal, _, err := env.Client.DataRemovals.Get(context.Background(), 1234)
+			Expect(err).To(BeNil())
+			Expect(removal).To(Equal(&DataRemoval{ID: 1234, Status: DataRemovalDone}))
+		})
+	})
+
+	Describe("Waiting for a data removal", func() {
+		var polls int32
+
+		BeforeEach(func() {
+			atomic.StoreInt32(&polls, 0)
+			env.Mux.HandleFunc("/data-removals/1234", func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&polls, 1) < 3 {
+					fmt.Fprintf(w, `{ "ID": 1234, "Status": "Pending" }`)
+					return
+				}
+				fmt.Fprintf(w, `{ "ID": 1234, "Status": "Done" }`)
+			})
+		})
+
+		It("should poll until the removal is done", func() {
+			removal, err := env.Client.DataRemovals.Wait(context.Background(), 1234, time.Millisecond)
+			Expect(err).To(BeNil())
+			Expect(removal.Status).To(Equal(DataRemovalDone))
+			Expect(atomic.LoadInt32(&polls)).To(Equal(int32(3)))
+		})
+
+		It("should stop when the context is done", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			_, err := env.Client.DataRemovals.Wait(ctx, 1234, time.Hour)
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(atomic.LoadInt32(&polls)).To(Equal(int32(1)))
+		})
+
+		It("should not poll continuously without an interval", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			_, err := env.Client.DataRemovals.Wait(ctx, 1234, 0)
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(atomic.LoadInt32(&polls)).To(Equal(int32(1)))
+		})
+	})
+})
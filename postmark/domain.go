@@ -0,0 +1,162 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// DomainService handles communication with the Domains related methods of
+// the Postmark API. These methods are authenticated with the account token.
+type DomainService struct {
+	client *Client
+}
+
+// Domain represents a sending domain along with the DNS records needed to
+// verify it. The same type is used to create and edit domains, in which case
+// nil fields are left unchanged. The Name can only be set when creating a
+// domain.
+type Domain struct {
+	ID   *int64  `json:"ID,omitempty"`
+	Name *string `json:"Name,omitempty"`
+
+	SPFVerified  *bool   `json:"SPFVerified,omitempty"`
+	SPFHost      *string `json:"SPFHost,omitempty"`
+	SPFTextValue *string `json:"SPFTextValue,omitempty"`
+
+	// DKIMHost and DKIMTextValue are the TXT record of the active DKIM key.
+	// While a new key is being set up, its record is in DKIMPendingHost and
+	// DKIMPendingTextValue, and the record of a replaced key is in
+	// DKIMRevokedHost and DKIMRevokedTextValue until it is safe to remove.
+	DKIMVerified                  *bool   `json:"DKIMVerified,omitempty"`
+	WeakDKIM                      *bool   `json:"WeakDKIM,omitempty"`
+	DKIMHost                      *string `json:"DKIMHost,omitempty"`
+	DKIMTextValue                 *string `json:"DKIMTextValue,omitempty"`
+	DKIMPendingHost               *string `json:"DKIMPendingHost,omitempty"`
+	DKIMPendingTextValue          *string `json:"DKIMPendingTextValue,omitempty"`
+	DKIMRevokedHost               *string `json:"DKIMRevokedHost,omitempty"`
+	DKIMRevokedTextValue          *string `json:"DKIMRevokedTextValue,omitempty"`
+	SafeToRemoveRevokedKeyFromDNS *bool   `json:"SafeToRemoveRevokedKeyFromDNS,omitempty"`
+	DKIMUpdateStatus              *string `json:"DKIMUpdateStatus,omitempty"`
+
+	// ReturnPathDomain must be a subdomain of the domain, with a CNAME record
+	// pointing to ReturnPathDomainCNAMEValue.
+	ReturnPathDomain           *string `json:"ReturnPathDomain,omitempty"`
+	ReturnPathDomainVerified   *bool   `json:"ReturnPathDomainVerified,omitempty"`
+	ReturnPathDomainCNAMEValue *string `json:"ReturnPathDomainCNAMEValue,omitempty"`
+}
+
+// DomainList is a page of domains returned by DomainService.List.
+type DomainList struct {
+	TotalCount int
+	Domains    []Domain
+}
+
+// DomainListOptions specifies the optional parameters to the
+// DomainService.List method.
+type DomainListOptions struct {
+	ListOptions
+}
+
+// List returns the domains of the account. The listed domains only contain
+// the verification statuses, not the DNS records.
+func (s *DomainService) List(ctx context.Context, opt *DomainListOptions) (*DomainList, *http.Response, error) {
+	u, err := addOptions("domains", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newAccountRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	domains := new(DomainList)
+	resp, err := s.client.Do(req, domains)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return domains, resp, err
+}
+
+// ListAll returns an iterator over all the domains of the account, with their
+// verification statuses only. See Paginate.
+func (s *DomainService) ListAll(ctx context.Context, opt *DomainListOptions) iter.Seq2[Domain, error] {
+	var base DomainListOptions
+	if opt != nil {
+		base = *opt
+	}
+
+	return Paginate(ctx, &base.ListOptions, func(ctx context.Context, page ListOptions) ([]Domain, int, error) {
+		o := base
+		o.ListOptions = page
+
+		list, _, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Domains, list.TotalCount, nil
+	})
+}
+
+// Get returns a single domain.
+func (s *DomainService) Get(ctx context.Context, id int64) (*Domain, *http.Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("domains/%d", id), nil)
+}
+
+// Create creates a new domain.
+func (s *DomainService) Create(ctx context.Context, domain *Domain) (*Domain, *http.Response, error) {
+	return s.do(ctx, "POST", "domains", domain)
+}
+
+// Edit updates the Return-Path domain of a domain.
+func (s *DomainService) Edit(ctx context.Context, id int64, domain *Domain) (*Domain, *http.Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("domains/%d", id), domain)
+}
+
+// Delete deletes a domain.
+func (s *DomainService) Delete(ctx context.Context, id int64) (*http.Response, error) {
+	u := fmt.Sprintf("domains/%d", id)
+	req, err := s.client.newAccountRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// VerifyDKIM checks the DKIM DNS record of a domain and returns the updated
+// domain.
+func (s *DomainService) VerifyDKIM(ctx context.Context, id int64) (*Domain, *http.Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("domains/%d/verifyDkim", id), nil)
+}
+
+// VerifyReturnPath checks the Return-Path DNS record of a domain and returns
+// the updated domain.
+func (s *DomainService) VerifyReturnPath(ctx context.Context, id int64) (*Domain, *http.Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("domains/%d/verifyReturnPath", id), nil)
+}
+
+// RotateDKIM creates a new DKIM key for a domain. The record of the new key
+// is returned in the pending DKIM fields until it is verified.
+func (s *DomainService) RotateDKIM(ctx context.Context, id int64) (*Domain, *http.Response, error) {
+	return s.do(ctx, "POST", fmt.Sprintf("domains/%d/rotatedkim", id), nil)
+}
+
+// do sends a request to one of the endpoints that return a single domain.
+func (s *DomainService) do(ctx context.Context, method, u string, body interface{}) (*Domain, *http.Response, error) {
+	req, err := s.client.newAccountRequest(ctx, method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	domain := new(Domain)
+	resp, err := s.client.Do(req, domain)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return domain, resp, err
+}
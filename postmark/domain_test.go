@@ -0,0 +1,174 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+var _ = Describe("Domain", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	Describe("Listing domains", func() {
+		It("should return the domains using the account token", func() {
+			env.Mux.HandleFunc("/domains", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				Expect(r.URL.Query().Get("count")).To(Equal("50"))
+				env.assertAccountHeaders(r)
+				fmt.Fprintf(w, `{
+					"TotalCount": 1,
+					"Domains": [{
+						"Name": "example.com",
+						"SPFVerified": true,
+						"DKIMVerified": false,
+						"ReturnPathDomainVerified": false,
+						"ID": 36735
+					}]
+				}`)
+			})
+
+			domains, _, err := env.Client.Domains.List(context.Background(), &DomainListOptions{
+				ListOptions: ListOptions{Count: 50},
+			})
+			Expect(err).To(BeNil())
+			Expect(domains).To(Equal(&DomainList{
+				TotalCount: 1,
+				Domains: []Domain{{
+					Name:                     String("example.com"),
+					SPFVerified:              Bool(true),
+					DKIMVerified:             Bool(false),
+					ReturnPathDomainVerified: Bool(false),
+					ID:                       Int64(36735),
+				}},
+			}))
+		})
+	})
+
+	Describe("Getting a domain", func() {
+		It("should return the DNS records", func() {
+			env.Mux.HandleFunc("/domains/36735", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				env.assertAccountHeaders(r)
+				fmt.Fprintf(w, `{
+					"ID": 36735,
+					"Name": "example.com",
+					"DKIMHost": "20131031155228pm._domainkey.example.com",
+					"DKIMTextValue": "k=rsa;p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQCFn",
+					"ReturnPathDomain": "pmbounces.example.com",
+					"ReturnPathDomainCNAMEValue": "pm.mtasv.net"
+				}`)
+			})
+
+			domain, _, err := env.Client.Domains.Get(context.Background(), 36735)
+			Expect(err).To(BeNil())
+			Expect(domain).To(Equal(&Domain{
+				ID:                         Int64(36735),
+				Name:                       String("example.com"),
+				DKIMHost:                   String("20131031155228pm._domainkey.example.com"),
+				DKIMTextValue:              String("k=rsa;p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQCFn"),
+				ReturnPathDomain:           String("pmbounces.example.com"),
+				ReturnPathDomainCNAMEValue: String("pm.mtasv.net"),
+			}))
+		})
+	})
+
+	Describe("Creating a domain", func() {
+		It("should post the domain", func() {
+			env.Mux.HandleFunc("/domains", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{
+					"Name": "newdomain.com",
+					"ReturnPathDomain": "pm-bounces.newdomain.com"
+				}`))
+				fmt.Fprintf(w, `{ "ID": 36736, "Name": "newdomain.com" }`)
+			})
+
+			domain, _, err := env.Client.Domains.Create(context.Background(), &Domain{
+				Name:             String("newdomain.com"),
+				ReturnPathDomain: String("pm-bounces.newdomain.com"),
+			})
+			Expect(err).To(BeNil())
+			Expect(*domain.ID).To(Equal(int64(36736)))
+		})
+	})
+
+	Describe("Editing a domain", func() {
+		It("should put the domain", func() {
+			env.Mux.HandleFunc("/domains/36736", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PUT"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{ "ReturnPathDomain": "bounces.newdomain.com" }`))
+				fmt.Fprintf(w, `{ "ID": 36736 }`)
+			})
+
+			_, _, err := env.Client.Domains.Edit(context.Background(), 36736, &Domain{
+				ReturnPathDomain: String("bounces.newdomain.com"),
+			})
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Deleting a domain", func() {
+		It("should send a delete request", func() {
+			env.Mux.HandleFunc("/domains/36736", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("DELETE"))
+				env.assertAccountHeaders(r)
+				fmt.Fprintf(w, `{ "ErrorCode": 0, "Message": "Domain newdomain.com removed." }`)
+			})
+
+			_, err := env.Client.Domains.Delete(context.Background(), 36736)
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Verifying and rotating DKIM", func() {
+		It("should put to the verify DKIM endpoint", func() {
+			env.Mux.HandleFunc("/domains/36736/verifyDkim", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PUT"))
+				fmt.Fprintf(w, `{ "ID": 36736, "DKIMVerified": true }`)
+			})
+
+			domain, _, err := env.Client.Domains.VerifyDKIM(context.Background(), 36736)
+			Expect(err).To(BeNil())
+			Expect(*domain.DKIMVerified).To(BeTrue())
+		})
+
+		It("should put to the verify Return-Path endpoint", func() {
+			env.Mux.HandleFunc("/domains/36736/verifyReturnPath", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PUT"))
+				fmt.Fprintf(w, `{ "ID": 36736, "ReturnPathDomainVerified": true }`)
+			})
+
+			domain, _, err := env.Client.Domains.VerifyReturnPath(context.Background(), 36736)
+			Expect(err).To(BeNil())
+			Expect(*domain.ReturnPathDomainVerified).To(BeTrue())
+		})
+
+		It("should post to the rotate DKIM endpoint", func() {
+			env.Mux.HandleFunc("/domains/36736/rotatedkim", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				fmt.Fprintf(w, `{ "ID": 36736, "DKIMUpdateStatus": "Pending", "DKIMPendingHost": "new._domainkey.newdomain.com" }`)
+			})
+
+			domain, _, err := env.Client.Domains.RotateDKIM(context.Background(), 36736)
+			Expect(err).To(BeNil())
+			Expect(*domain.DKIMUpdateStatus).To(Equal("Pending"))
+			Expect(*domain.DKIMPendingHost).To(Equal("new._domainkey.newdomain.com"))
+		})
+	})
+})
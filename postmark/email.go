@@ -1,9 +1,13 @@
 package postmark
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"sync"
 	"time"
 )
 
@@ -26,6 +30,10 @@ type Email struct {
 	Headers     []Header     `json:"Headers,omitempty"`
 	TrackOpens  *bool        `json:"TrackOpens,omitempty"`
 	Attachments []Attachment `json:"Attachments,omitempty"`
+
+	// MessageStream is the ID of the message stream to send through. The
+	// server's default transactional stream is used when nil.
+	MessageStream *string `json:"MessageStream,omitempty"`
 }
 
 type Header struct {
@@ -60,8 +68,11 @@ type attachment Attachment
 func (a *Attachment) MarshalJSON() ([]byte, error) {
 	temp := attachment(*a)
 
-	// encode the content using base64 as specified by the Postmark API
-	*temp.Content = encodeBase64(*a.Content)
+	// encode the content using base64 as specified by the Postmark API, in a
+	// new string so the attachment is left unchanged
+	if a.Content != nil {
+		temp.Content = String(encodeBase64(*a.Content))
+	}
 
 	return json.Marshal(temp)
 }
@@ -74,7 +85,10 @@ func (a *Attachment) UnmarshalJSON(b []byte) error {
 	}
 
 	*a = Attachment(temp)
-	*a.Content, _ = decodeBase64(*temp.Content)
+	if temp.Content != nil {
+		content, _ := decodeBase64(*temp.Content)
+		a.Content = String(content)
+	}
 	return nil
 }
 
@@ -82,19 +96,146 @@ type EmailResult struct {
 	To          string
 	SubmittedAt *time.Time
 	MessageID   string
+
+	// ErrorCode and Message report why a message of a batch was not sent.
+	// ErrorCode is zero for messages that were sent.
+	ErrorCode int
+	Message   string
+
+	// Err is the error of the request that should have sent the message, when
+	// EmailService.SendBatchChunked could not send its chunk.
+	Err error `json:"-"`
+}
+
+// BatchError is returned by the batch send methods when some messages of the
+// batch were not sent. Postmark accepts the rest of the batch, and their
+// results are returned along with the error.
+type BatchError struct {
+	Errors []BatchMessageError
+}
+
+func (e *BatchError) Error() string {
+	msg := fmt.Sprintf("postmark: %d batch message(s) failed", len(e.Errors))
+	if len(e.Errors) > 0 {
+		msg += ", first: " + e.Errors[0].Error()
+	}
+	return msg
+}
+
+// Unwrap returns the errors of the messages, so that errors.Is reports
+// whether any message failed with a given error.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i := range e.Errors {
+		errs[i] = &e.Errors[i]
+	}
+	return errs
+}
+
+// BatchMessageError describes why a message of a batch was not sent.
+type BatchMessageError struct {
+	// Index is the index of the message in the batch.
+	Index     int
+	ErrorCode int
+	Message   string
+
+	// Err is the error of the request that should have sent the message, if
+	// the message was not rejected on its own.
+	Err error
+}
+
+func (e *BatchMessageError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("message %d: %v", e.Index, e.Err)
+	}
+	return fmt.Sprintf("message %d: API error %d %q", e.Index, e.ErrorCode, e.Message)
+}
+
+// Unwrap returns the error of the request that should have sent the message.
+func (e *BatchMessageError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the sentinel error for the error code of e.
+func (e *BatchMessageError) Is(target error) bool {
+	sentinel, ok := errorCodes[e.ErrorCode]
+	return ok && sentinel == target
+}
+
+// batchError returns a *BatchError listing the failed results, or nil if
+// every message was sent.
+func batchError(results []EmailResult) error {
+	var errs []BatchMessageError
+	for i, r := range results {
+		if r.ErrorCode != 0 || r.Err != nil {
+			errs = append(errs, BatchMessageError{
+				Index:     i,
+				ErrorCode: r.ErrorCode,
+				Message:   r.Message,
+				Err:       r.Err,
+			})
+		}
+	}
+
+	if errs == nil {
+		return nil
+	}
+	return &BatchError{Errors: errs}
+}
+
+// BulkEmail is a message sent to many recipients through the bulk endpoint.
+// The Subject, HTMLBody and TextBody may contain template variables, which are
+// rendered with the TemplateModel of each message.
+type BulkEmail struct {
+	From          *string      `json:"From,omitempty"`
+	Subject       *string      `json:"Subject,omitempty"`
+	Tag           *string      `json:"Tag,omitempty"`
+	HTMLBody      *string      `json:"HtmlBody,omitempty"`
+	TextBody      *string      `json:"TextBody,omitempty"`
+	ReplyTo       *string      `json:"ReplyTo,omitempty"`
+	Headers       []Header     `json:"Headers,omitempty"`
+	TrackOpens    *bool        `json:"TrackOpens,omitempty"`
+	Attachments   []Attachment `json:"Attachments,omitempty"`
+	MessageStream *string      `json:"MessageStream,omitempty"`
+
+	Messages []BulkMessage `json:"Messages"`
+}
+
+// BulkMessage holds the recipients of a single message of a BulkEmail and
+// the values used to render it.
+type BulkMessage struct {
+	To            *string       `json:"To,omitempty"`
+	Cc            *string       `json:"Cc,omitempty"`
+	Bcc           *string       `json:"Bcc,omitempty"`
+	TemplateModel TemplateModel `json:"TemplateModel,omitempty"`
 }
 
-func (s *EmailService) Send(email *Email) (*EmailResult, *http.Response, error) {
-	req, err := s.client.NewRequest("POST", "email", email)
+// BulkEmailStatus is the processing status of a bulk request.
+type BulkEmailStatus string
+
+// The bulk request statuses reported by the Postmark API.
+const (
+	BulkEmailAccepted   BulkEmailStatus = "Accepted"
+	BulkEmailProcessing BulkEmailStatus = "Processing"
+	BulkEmailCompleted  BulkEmailStatus = "Completed"
+)
+
+// BulkEmailResult is the status of a bulk request. TotalMessages and
+// PercentageCompleted are only reported by EmailService.GetBulkStatus.
+type BulkEmailResult struct {
+	ID                  string
+	Status              BulkEmailStatus
+	SubmittedAt         *time.Time
+	TotalMessages       int
+	PercentageCompleted float64
+}
+
+func (s *EmailService) Send(ctx context.Context, email *Email) (*EmailResult, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "POST", "email", email)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// set headers
-	req.Header.Set(headerContentType, contentType)
-	req.Header.Set(headerAccept, acceptType)
-	req.Header.Set(headerServerToken, s.client.ServerToken)
-
 	result := new(EmailResult)
 	resp, err := s.client.Do(req, result)
 	if err != nil {
@@ -104,16 +245,51 @@ func (s *EmailService) Send(email *Email) (*EmailResult, *http.Response, error)
 	return result, resp, err
 }
 
-func (s *EmailService) SendBatch(emails []Email) ([]EmailResult, *http.Response, error) {
-	req, err := s.client.NewRequest("POST", "email/batch", emails)
+// SendBatch sends a batch of emails. The results are in the order of the
+// emails, and if some were not sent, a *BatchError lists them.
+//
+// When err is a *BatchError, Postmark accepted the batch: the results are
+// valid and the messages missing from the BatchError were sent. Resending
+// the whole batch then sends them twice, so only resend the failed messages.
+func (s *EmailService) SendBatch(ctx context.Context, emails []Email) ([]EmailResult, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "POST", "email/batch", emails)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := new([]EmailResult)
+	resp, err := s.client.Do(req, results)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return *results, resp, batchError(*results)
+}
+
+// SendWithTemplate sends an email rendered from a template.
+func (s *EmailService) SendWithTemplate(ctx context.Context, email *TemplatedEmail) (*EmailResult, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "POST", "email/withTemplate", email)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// set headers
-	req.Header.Set(headerContentType, contentType)
-	req.Header.Set(headerAccept, acceptType)
-	req.Header.Set(headerServerToken, s.client.ServerToken)
+	result := new(EmailResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, err
+}
+
+// SendBatchWithTemplates sends a batch of emails rendered from templates. Like
+// SendBatch, the results are valid when err is a *BatchError, which lists the
+// emails that were not sent.
+func (s *EmailService) SendBatchWithTemplates(ctx context.Context, emails []TemplatedEmail) ([]EmailResult, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "POST", "email/batchWithTemplates", &templatedBatch{Messages: emails})
+	if err != nil {
+		return nil, nil, err
+	}
 
 	results := new([]EmailResult)
 	resp, err := s.client.Do(req, results)
@@ -121,5 +297,215 @@ func (s *EmailService) SendBatch(emails []Email) ([]EmailResult, *http.Response,
 		return nil, resp, err
 	}
 
-	return *results, resp, err
+	return *results, resp, batchError(*results)
+}
+
+// The limits of a single batch request enforced by the Postmark API.
+const (
+	MaxBatchMessages = 500
+	MaxBatchSize     = 50 * 1000 * 1000
+)
+
+// errMissingResult is the error of the messages of a chunk for which the API
+// returned no result.
+var errMissingResult = errors.New("postmark: no result returned for the message")
+
+// BatchOptions specifies the optional parameters to the
+// EmailService.SendBatchChunked method.
+type BatchOptions struct {
+	// MaxMessages and MaxSize are the maximum number of messages and the
+	// maximum encoded size in bytes of a chunk. They default to
+	// MaxBatchMessages and MaxBatchSize.
+	MaxMessages int
+	MaxSize     int
+
+	// Concurrency is the number of chunks sent at the same time. Chunks are
+	// sent one at a time by default.
+	Concurrency int
+}
+
+// SendBatchChunked sends any number of emails, split into as many batch
+// requests as needed to stay within the limits of the API. The results are in
+// the order of the emails, and if some were not sent, a *BatchError lists
+// them. The emails of a chunk that could not be sent at all carry the error
+// of its request in their EmailResult.Err.
+func (s *EmailService) SendBatchChunked(ctx context.Context, emails []Email, opt *BatchOptions) ([]EmailResult, error) {
+	if opt == nil {
+		opt = &BatchOptions{}
+	}
+	maxMessages, maxSize, concurrency := opt.MaxMessages, opt.MaxSize, opt.Concurrency
+	if maxMessages <= 0 {
+		maxMessages = MaxBatchMessages
+	}
+	if maxSize <= 0 {
+		maxSize = MaxBatchSize
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	results := make([]EmailResult, len(emails))
+
+	// Encode every email once, both to measure it and to send it.
+	encoded := make([]json.RawMessage, len(emails))
+	for i := range emails {
+		b, err := json.Marshal(&emails[i])
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		encoded[i] = b
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+	for _, chunk := range chunkBatch(encoded, maxMessages, maxSize) {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(chunk []int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			s.sendChunk(ctx, encoded, chunk, results)
+		}(chunk)
+	}
+	wg.Wait()
+
+	return results, batchError(results)
+}
+
+// chunkBatch splits the indexes of the encoded emails into chunks of at most
+// maxMessages emails and maxSize bytes, once encoded as a JSON array. Emails
+// that failed to encode are skipped, and an email larger than maxSize is put
+// in a chunk of its own, for the API to reject it.
+func chunkBatch(encoded []json.RawMessage, maxMessages, maxSize int) [][]int {
+	var (
+		chunks [][]int
+		chunk  []int
+		size   int
+	)
+	for i, b := range encoded {
+		if b == nil {
+			continue
+		}
+
+		// Each email adds its length and a separating comma to the
+		// brackets of the array.
+		n := len(b) + 1
+		if len(chunk) > 0 && (len(chunk) == maxMessages || size+n > maxSize) {
+			chunks = append(chunks, chunk)
+			chunk, size = nil, 0
+		}
+		if len(chunk) == 0 {
+			size = 1
+		}
+
+		chunk = append(chunk, i)
+		size += n
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// sendChunk sends the encoded emails with the given indexes as a batch, and
+// stores their results at the same indexes.
+func (s *EmailService) sendChunk(ctx context.Context, encoded []json.RawMessage, chunk []int, results []EmailResult) {
+	batch := make([]json.RawMessage, len(chunk))
+	for i, idx := range chunk {
+		batch[i] = encoded[idx]
+	}
+
+	var chunkResults []EmailResult
+	req, err := s.client.newServerRequest(ctx, "POST", "email/batch", batch)
+	if err == nil {
+		_, err = s.client.Do(req, &chunkResults)
+	}
+
+	for i, idx := range chunk {
+		switch {
+		case err != nil:
+			results[idx].Err = err
+			var er *ErrorResponse
+			if errors.As(err, &er) {
+				results[idx].ErrorCode = er.ErrorCode
+				results[idx].Message = er.Message
+			}
+		case i < len(chunkResults):
+			results[idx] = chunkResults[i]
+		default:
+			results[idx].Err = errMissingResult
+		}
+	}
+}
+
+// SendBulk submits a bulk request, which Postmark processes asynchronously.
+// Use the ID of the result to follow its progress with GetBulkStatus or
+// WaitBulk.
+func (s *EmailService) SendBulk(ctx context.Context, email *BulkEmail) (*BulkEmailResult, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "POST", "email/bulk", email)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(BulkEmailResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, err
+}
+
+// GetBulkStatus returns the status of a bulk request.
+func (s *EmailService) GetBulkStatus(ctx context.Context, id string) (*BulkEmailResult, *http.Response, error) {
+	u := fmt.Sprintf("email/bulk/%s", id)
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(BulkEmailResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, err
+}
+
+// WaitBulk polls the status of a bulk request every interval until it is no
+// longer accepted or processing, and returns the final status. It returns
+// ctx.Err() if ctx is done first. An interval of zero or less polls every
+// second.
+func (s *EmailService) WaitBulk(ctx context.Context, id string, interval time.Duration) (*BulkEmailResult, error) {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		result, _, err := s.GetBulkStatus(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Status != BulkEmailAccepted && result.Status != BulkEmailProcessing {
+			return result, nil
+		}
+
+		timer.Reset(interval)
+	}
 }
@@ -1,15 +1,20 @@
 package postmark_test
 
 import (
-	. "github.com/hudl/go-postmark/postmark"
+	. "github.com/hudl/go-postmark/v2/postmark"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"sync/atomic"
+	"time"
 )
 
 // encodeBase64 is a helper function that base64 encodes a string and returns
@@ -69,6 +74,18 @@ var _ = Describe("Email", func() {
 				a, _ := json.Marshal(attachment)
 				Expect(a).To(MatchJSON(attachmentJSON))
 			})
+
+			It("should leave the attachment unchanged", func() {
+				json.Marshal(attachment)
+				a, _ := json.Marshal(attachment)
+				Expect(a).To(MatchJSON(attachmentJSON))
+				Expect(*attachment.Content).To(Equal(content))
+			})
+
+			It("should allow an empty content", func() {
+				_, err := json.Marshal(&Attachment{Name: String("Name")})
+				Expect(err).To(BeNil())
+			})
 		})
 
 		Context("from JSON", func() {
@@ -83,6 +100,13 @@ var _ = Describe("Email", func() {
 				json.Unmarshal([]byte(attachmentJSON), a)
 				Expect(*a.Content).To(Equal(content))
 			})
+
+			It("should allow an empty content", func() {
+				a := new(Attachment)
+				err := json.Unmarshal([]byte(`{ "Name": "Name" }`), a)
+				Expect(err).To(BeNil())
+				Expect(a.Content).To(BeNil())
+			})
 		})
 	})
 
@@ -106,23 +130,23 @@ var _ = Describe("Email", func() {
 			})
 
 			It("should not return an error", func() {
-				_, _, err := env.Client.Email.Send(&Email{})
+				_, _, err := env.Client.Email.Send(context.Background(), &Email{})
 				Expect(err).To(BeNil())
 			})
 
 			It("should post to the /email endpoint", func() {
-				_, resp, _ := env.Client.Email.Send(&Email{})
+				_, resp, _ := env.Client.Email.Send(context.Background(), &Email{})
 				Expect(resp.Request.Method).To(Equal("POST"))
 				Expect(resp.Request.URL.Path).To(Equal("/email"))
 			})
 
 			It("should use the correct headers", func() {
-				_, resp, _ := env.Client.Email.Send(&Email{})
+				_, resp, _ := env.Client.Email.Send(context.Background(), &Email{})
 				assertEmailHeaders(resp.Request)
 			})
 
 			It("should return the correct result", func() {
-				result, _, _ := env.Client.Email.Send(&Email{})
+				result, _, _ := env.Client.Email.Send(context.Background(), &Email{})
 				Expect(result).To(Equal(&EmailResult{
 					To:        "receiver@example.com",
 					MessageID: "MessageID",
@@ -130,6 +154,19 @@ var _ = Describe("Email", func() {
 			})
 		})
 
+		Context("with a message stream", func() {
+			It("should include the message stream in the request", func() {
+				env.Mux.HandleFunc("/email", func(w http.ResponseWriter, r *http.Request) {
+					body, _ := ioutil.ReadAll(r.Body)
+					Expect(body).To(MatchJSON(`{ "MessageStream": "broadcasts" }`))
+					fmt.Fprintf(w, `{ "MessageID": "MessageID" }`)
+				})
+
+				_, _, err := env.Client.Email.Send(context.Background(), &Email{MessageStream: String("broadcasts")})
+				Expect(err).To(BeNil())
+			})
+		})
+
 		Context("when an API error is returned", func() {
 			BeforeEach(func() {
 				env.Mux.HandleFunc("/email", func(w http.ResponseWriter, r *http.Request) {
@@ -143,7 +180,7 @@ var _ = Describe("Email", func() {
 			})
 
 			It("should return a Postmark error", func() {
-				_, resp, err := env.Client.Email.Send(&Email{})
+				_, resp, err := env.Client.Email.Send(context.Background(), &Email{})
 				Expect(err).NotTo(BeNil())
 				Expect(err).To(Equal(&ErrorResponse{
 					Response:  resp,
@@ -177,23 +214,23 @@ var _ = Describe("Email", func() {
 			})
 
 			It("should not return an error", func() {
-				_, _, err := env.Client.Email.SendBatch([]Email{})
+				_, _, err := env.Client.Email.SendBatch(context.Background(), []Email{})
 				Expect(err).To(BeNil())
 			})
 
 			It("should post to the /email endpoint", func() {
-				_, resp, _ := env.Client.Email.SendBatch([]Email{})
+				_, resp, _ := env.Client.Email.SendBatch(context.Background(), []Email{})
 				Expect(resp.Request.Method).To(Equal("POST"))
 				Expect(resp.Request.URL.Path).To(Equal("/email/batch"))
 			})
 
 			It("should use the correct headers", func() {
-				_, resp, _ := env.Client.Email.SendBatch([]Email{})
+				_, resp, _ := env.Client.Email.SendBatch(context.Background(), []Email{})
 				assertEmailHeaders(resp.Request)
 			})
 
 			It("should return the correct results", func() {
-				results, _, _ := env.Client.Email.SendBatch([]Email{})
+				results, _, _ := env.Client.Email.SendBatch(context.Background(), []Email{})
 				Expect(results).To(Equal([]EmailResult{
 					EmailResult{
 						To:        "receiver1@example.com",
@@ -207,4 +244,344 @@ var _ = Describe("Email", func() {
 			})
 		})
 	})
+
+	Describe("Sending a batch email with failed messages", func() {
+		BeforeEach(func() {
+			env = newTestEnv()
+			env.Mux.HandleFunc("/email/batch", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `[{
+					"To": "receiver1@example.com",
+					"MessageID": "MessageID1",
+					"ErrorCode": 0,
+					"Message": "OK"
+				}, {
+					"ErrorCode": 406,
+					"Message": "You tried to send to a recipient that has been marked as inactive."
+				}, {
+					"ErrorCode": 300,
+					"Message": "Invalid 'To' address: 'receiver3'."
+				}]`)
+			})
+		})
+
+		AfterEach(func() {
+			env.StopServer()
+		})
+
+		It("should return the results of every message", func() {
+			results, _, _ := env.Client.Email.SendBatch(context.Background(), make([]Email, 3))
+			Expect(results).To(HaveLen(3))
+			Expect(results[0].MessageID).To(Equal("MessageID1"))
+			Expect(results[1].ErrorCode).To(Equal(406))
+		})
+
+		It("should return a batch error listing the failed messages", func() {
+			_, _, err := env.Client.Email.SendBatch(context.Background(), make([]Email, 3))
+
+			var batchErr *BatchError
+			Expect(errors.As(err, &batchErr)).To(BeTrue())
+			Expect(batchErr.Errors).To(Equal([]BatchMessageError{{
+				Index:     1,
+				ErrorCode: ErrorCodeInactiveRecipient,
+				Message:   "You tried to send to a recipient that has been marked as inactive.",
+			}, {
+				Index:     2,
+				ErrorCode: ErrorCodeInvalidEmailRequest,
+				Message:   "Invalid 'To' address: 'receiver3'.",
+			}}))
+		})
+
+		It("should match the errors of the failed messages", func() {
+			_, _, err := env.Client.Email.SendBatch(context.Background(), make([]Email, 3))
+			Expect(errors.Is(err, ErrInactiveRecipient)).To(BeTrue())
+			Expect(errors.Is(err, ErrInvalidEmailRequest)).To(BeTrue())
+			Expect(errors.Is(err, ErrBadAPIToken)).To(BeFalse())
+		})
+	})
+
+	Describe("Sending a chunked batch email", func() {
+		var (
+			emails   []Email
+			requests int32
+		)
+
+		BeforeEach(func() {
+			env = newTestEnv()
+			atomic.StoreInt32(&requests, 0)
+
+			emails = make([]Email, 5)
+			for i := range emails {
+				emails[i].To = String(fmt.Sprintf("receiver%d@example.com", i))
+			}
+
+			// The handler returns the recipient of each message as its ID,
+			// and rejects the batches sent to receiver3.
+			env.Mux.HandleFunc("/email/batch", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+
+				var batch []Email
+				json.NewDecoder(r.Body).Decode(&batch)
+				Expect(len(batch)).To(BeNumerically("<=", 2))
+
+				results := make([]EmailResult, len(batch))
+				for i, e := range batch {
+					if *e.To == "receiver3@example.com" {
+						w.WriteHeader(422)
+						fmt.Fprintf(w, `{ "ErrorCode": 300, "Message": "Invalid email request" }`)
+						return
+					}
+					results[i] = EmailResult{To: *e.To, MessageID: *e.To}
+				}
+				json.NewEncoder(w).Encode(results)
+			})
+		})
+
+		AfterEach(func() {
+			env.StopServer()
+		})
+
+		It("should split the emails by count", func() {
+			results, _ := env.Client.Email.SendBatchChunked(context.Background(), emails, &BatchOptions{
+				MaxMessages: 2,
+			})
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(3)))
+			Expect(results).To(HaveLen(5))
+			Expect(results[0].MessageID).To(Equal("receiver0@example.com"))
+			Expect(results[4].MessageID).To(Equal("receiver4@example.com"))
+		})
+
+		It("should split the emails by encoded size", func() {
+			one, _ := json.Marshal(&emails[0])
+
+			results, _ := env.Client.Email.SendBatchChunked(context.Background(), emails, &BatchOptions{
+				MaxSize: 2*len(one) + 3,
+			})
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(3)))
+			Expect(results[1].MessageID).To(Equal("receiver1@example.com"))
+		})
+
+		It("should keep the input order when sending chunks concurrently", func() {
+			results, _ := env.Client.Email.SendBatchChunked(context.Background(), emails, &BatchOptions{
+				MaxMessages: 1,
+				Concurrency: 3,
+			})
+			Expect(atomic.LoadInt32(&requests)).To(Equal(int32(5)))
+			for i, r := range results {
+				if i != 3 {
+					Expect(r.MessageID).To(Equal(*emails[i].To))
+				}
+			}
+		})
+
+		It("should report the messages of a failed chunk", func() {
+			results, err := env.Client.Email.SendBatchChunked(context.Background(), emails, &BatchOptions{
+				MaxMessages: 2,
+			})
+			Expect(results[2].ErrorCode).To(Equal(ErrorCodeInvalidEmailRequest))
+			Expect(results[2].Err).To(BeAssignableToTypeOf(&ErrorResponse{}))
+			Expect(results[3].ErrorCode).To(Equal(ErrorCodeInvalidEmailRequest))
+			Expect(results[4].Err).To(BeNil())
+
+			var batchErr *BatchError
+			Expect(errors.As(err, &batchErr)).To(BeTrue())
+			Expect(batchErr.Errors).To(HaveLen(2))
+			Expect(batchErr.Errors[0].Index).To(Equal(2))
+			Expect(batchErr.Errors[1].Index).To(Equal(3))
+			Expect(errors.Is(err, ErrInvalidEmailRequest)).To(BeTrue())
+		})
+
+		It("should return the context error of the chunks that were not sent", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			results, err := env.Client.Email.SendBatchChunked(ctx, emails, nil)
+			Expect(results[0].Err).To(Equal(context.Canceled))
+			Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+			Expect(atomic.LoadInt32(&requests)).To(BeZero())
+		})
+	})
+
+	Describe("Sending an email with a template", func() {
+		BeforeEach(func() {
+			env = newTestEnv()
+		})
+
+		AfterEach(func() {
+			env.StopServer()
+		})
+
+		Context("with a template alias and model", func() {
+			BeforeEach(func() {
+				env.Mux.HandleFunc("/email/withTemplate", func(w http.ResponseWriter, r *http.Request) {
+					body, _ := ioutil.ReadAll(r.Body)
+					Expect(body).To(MatchJSON(`{
+						"TemplateAlias": "welcome",
+						"TemplateModel": { "name": "John" },
+						"To": "receiver@example.com"
+					}`))
+					fmt.Fprintf(w, `{
+						"To": "receiver@example.com",
+						"MessageID": "MessageID"
+					}`)
+				})
+			})
+
+			It("should post to the /email/withTemplate endpoint", func() {
+				result, resp, err := env.Client.Email.SendWithTemplate(context.Background(), &TemplatedEmail{
+					TemplateAlias: String("welcome"),
+					TemplateModel: TemplateModel{"name": "John"},
+					Email:         Email{To: String("receiver@example.com")},
+				})
+				Expect(err).To(BeNil())
+				Expect(resp.Request.Method).To(Equal("POST"))
+				assertEmailHeaders(resp.Request)
+				Expect(result).To(Equal(&EmailResult{
+					To:        "receiver@example.com",
+					MessageID: "MessageID",
+				}))
+			})
+		})
+	})
+
+	Describe("Sending a batch email with templates", func() {
+		BeforeEach(func() {
+			env = newTestEnv()
+		})
+
+		AfterEach(func() {
+			env.StopServer()
+		})
+
+		Context("with a valid batch", func() {
+			BeforeEach(func() {
+				env.Mux.HandleFunc("/email/batchWithTemplates", func(w http.ResponseWriter, r *http.Request) {
+					body, _ := ioutil.ReadAll(r.Body)
+					Expect(body).To(MatchJSON(`{
+						"Messages": [
+							{ "TemplateId": 1234, "TemplateModel": null }
+						]
+					}`))
+					fmt.Fprintf(w, `[{
+						"To": "receiver1@example.com",
+						"MessageID": "MessageID1"
+					}]`)
+				})
+			})
+
+			It("should wrap the emails in a messages object", func() {
+				results, _, err := env.Client.Email.SendBatchWithTemplates(context.Background(), []TemplatedEmail{
+					{TemplateID: Int64(1234)},
+				})
+				Expect(err).To(BeNil())
+				Expect(results).To(Equal([]EmailResult{{
+					To:        "receiver1@example.com",
+					MessageID: "MessageID1",
+				}}))
+			})
+		})
+	})
+
+	Describe("Sending a bulk email", func() {
+		BeforeEach(func() {
+			env = newTestEnv()
+		})
+
+		AfterEach(func() {
+			env.StopServer()
+		})
+
+		It("should post the messages and return the request ID", func() {
+			env.Mux.HandleFunc("/email/bulk", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				assertEmailHeaders(r)
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{
+					"From": "sender@example.com",
+					"Subject": "Hello {{name}}",
+					"MessageStream": "broadcast",
+					"Messages": [
+						{ "To": "receiver1@example.com", "TemplateModel": { "name": "John" } },
+						{ "To": "receiver2@example.com", "TemplateModel": { "name": "Jane" } }
+					]
+				}`))
+				fmt.Fprintf(w, `{
+					"ID": "f24af63c-533d-4b7a-ad65-4a7b3202d3a7",
+					"Status": "Accepted"
+				}`)
+			})
+
+			result, _, err := env.Client.Email.SendBulk(context.Background(), &BulkEmail{
+				From:          String("sender@example.com"),
+				Subject:       String("Hello {{name}}"),
+				MessageStream: String("broadcast"),
+				Messages: []BulkMessage{
+					{To: String("receiver1@example.com"), TemplateModel: TemplateModel{"name": "John"}},
+					{To: String("receiver2@example.com"), TemplateModel: TemplateModel{"name": "Jane"}},
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(&BulkEmailResult{
+				ID:     "f24af63c-533d-4b7a-ad65-4a7b3202d3a7",
+				Status: BulkEmailAccepted,
+			}))
+		})
+	})
+
+	Describe("Getting the status of a bulk email", func() {
+		var polls int32
+
+		BeforeEach(func() {
+			env = newTestEnv()
+			atomic.StoreInt32(&polls, 0)
+			env.Mux.HandleFunc("/email/bulk/BulkID", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				if atomic.AddInt32(&polls, 1) < 3 {
+					fmt.Fprintf(w, `{ "Id": "BulkID", "TotalMessages": 2, "PercentageCompleted": 0.5, "Status": "Processing" }`)
+					return
+				}
+				fmt.Fprintf(w, `{ "Id": "BulkID", "TotalMessages": 2, "PercentageCompleted": 1, "Status": "Completed" }`)
+			})
+		})
+
+		AfterEach(func() {
+			env.StopServer()
+		})
+
+		It("should return the progress", func() {
+			result, _, err := env.Client.Email.GetBulkStatus(context.Background(), "BulkID")
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(&BulkEmailResult{
+				ID:                  "BulkID",
+				Status:              BulkEmailProcessing,
+				TotalMessages:       2,
+				PercentageCompleted: 0.5,
+			}))
+		})
+
+		It("should poll until the request is completed", func() {
+			result, err := env.Client.Email.WaitBulk(context.Background(), "BulkID", time.Millisecond)
+			Expect(err).To(BeNil())
+			Expect(result.Status).To(Equal(BulkEmailCompleted))
+			Expect(result.PercentageCompleted).To(Equal(1.0))
+			Expect(atomic.LoadInt32(&polls)).To(Equal(int32(3)))
+		})
+
+		It("should stop when the context is done", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			_, err := env.Client.Email.WaitBulk(ctx, "BulkID", time.Hour)
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(atomic.LoadInt32(&polls)).To(Equal(int32(1)))
+		})
+
+		It("should not poll continuously without an interval", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			_, err := env.Client.Email.WaitBulk(ctx, "BulkID", 0)
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(atomic.LoadInt32(&polls)).To(Equal(int32(1)))
+		})
+	})
 })
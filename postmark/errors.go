@@ -0,0 +1,176 @@
+package postmark
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// The error codes returned by the Postmark API in ErrorResponse.ErrorCode.
+// See http://developer.postmarkapp.com/developer-api-overview.html#error-codes
+const (
+	ErrorCodeBadAPIToken                 = 10
+	ErrorCodeMaintenance                 = 100
+	ErrorCodeInvalidEmailRequest         = 300
+	ErrorCodeSenderSignatureNotFound     = 400
+	ErrorCodeSenderSignatureNotConfirmed = 401
+	ErrorCodeInvalidJSON                 = 402
+	ErrorCodeIncompatibleJSON            = 403
+	ErrorCodeNotAllowedToSend            = 405
+	ErrorCodeInactiveRecipient           = 406
+	ErrorCodeBounceNotFound              = 407
+	ErrorCodeJSONRequired                = 409
+	ErrorCodeTooManyBatchMessages        = 410
+	ErrorCodeForbiddenAttachmentType     = 411
+	ErrorCodeAccountIsPending            = 412
+	ErrorCodeAccountMayNotSend           = 413
+	ErrorCodeMessageNotFound             = 701
+	ErrorCodeTemplateNotFound            = 1101
+)
+
+// Sentinel errors matching the API errors with the corresponding error code,
+// to be used with errors.Is:
+//
+//	if errors.Is(err, postmark.ErrInactiveRecipient) {
+//		// ...
+//	}
+var (
+	ErrBadAPIToken                 = errors.New("postmark: bad or missing API token")
+	ErrMaintenance                 = errors.New("postmark: API is offline for maintenance")
+	ErrInvalidEmailRequest         = errors.New("postmark: invalid email request")
+	ErrSenderSignatureNotFound     = errors.New("postmark: sender signature not found")
+	ErrSenderSignatureNotConfirmed = errors.New("postmark: sender signature not confirmed")
+	ErrInvalidJSON                 = errors.New("postmark: invalid JSON")
+	ErrIncompatibleJSON            = errors.New("postmark: incompatible JSON")
+	ErrNotAllowedToSend            = errors.New("postmark: not allowed to send")
+	ErrInactiveRecipient           = errors.New("postmark: inactive recipient")
+	ErrBounceNotFound              = errors.New("postmark: bounce not found")
+	ErrJSONRequired                = errors.New("postmark: JSON required")
+	ErrTooManyBatchMessages        = errors.New("postmark: too many batch messages")
+	ErrForbiddenAttachmentType     = errors.New("postmark: forbidden attachment type")
+	ErrAccountIsPending            = errors.New("postmark: account is pending")
+	ErrAccountMayNotSend           = errors.New("postmark: account may not send")
+	ErrMessageNotFound             = errors.New("postmark: message not found")
+	ErrTemplateNotFound            = errors.New("postmark: template not found")
+
+	// ErrRateLimited matches the errors of requests rejected with a
+	// 429 Too Many Requests status, which carry no error code.
+	ErrRateLimited = errors.New("postmark: rate limited")
+)
+
+// errorCodes maps the error codes to their sentinel error.
+var errorCodes = map[int]error{
+	ErrorCodeBadAPIToken:                 ErrBadAPIToken,
+	ErrorCodeMaintenance:                 ErrMaintenance,
+	ErrorCodeInvalidEmailRequest:         ErrInvalidEmailRequest,
+	ErrorCodeSenderSignatureNotFound:     ErrSenderSignatureNotFound,
+	ErrorCodeSenderSignatureNotConfirmed: ErrSenderSignatureNotConfirmed,
+	ErrorCodeInvalidJSON:                 ErrInvalidJSON,
+	ErrorCodeIncompatibleJSON:            ErrIncompatibleJSON,
+	ErrorCodeNotAllowedToSend:            ErrNotAllowedToSend,
+	ErrorCodeInactiveRecipient:           ErrInactiveRecipient,
+	ErrorCodeBounceNotFound:              ErrBounceNotFound,
+	ErrorCodeJSONRequired:                ErrJSONRequired,
+	ErrorCodeTooManyBatchMessages:        ErrTooManyBatchMessages,
+	ErrorCodeForbiddenAttachmentType:     ErrForbiddenAttachmentType,
+	ErrorCodeAccountIsPending:            ErrAccountIsPending,
+	ErrorCodeAccountMayNotSend:           ErrAccountMayNotSend,
+	ErrorCodeMessageNotFound:             ErrMessageNotFound,
+	ErrorCodeTemplateNotFound:            ErrTemplateNotFound,
+}
+
+// Is reports whether target is the sentinel error for the error code of e,
+// which makes errors.Is(err, ErrInactiveRecipient) work.
+func (e *ErrorResponse) Is(target error) bool {
+	if target == ErrRateLimited {
+		return e.statusCode() == http.StatusTooManyRequests
+	}
+
+	sentinel, ok := errorCodes[e.ErrorCode]
+	return ok && sentinel == target
+}
+
+// IsRetryable reports whether the request failed because of a transient
+// condition, such as rate limiting or maintenance, and may succeed if it is
+// sent again later. Postmark did not process such requests, so sending them
+// again cannot send an email twice. Other server errors, such as 500, may be
+// returned for processed requests and are not retryable.
+func (e *ErrorResponse) IsRetryable() bool {
+	switch e.statusCode() {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	}
+	return e.ErrorCode == ErrorCodeMaintenance
+}
+
+// IsPermanent reports whether the request was rejected and will fail again
+// if it is sent unchanged, for instance because of an invalid email or an
+// inactive recipient.
+func (e *ErrorResponse) IsPermanent() bool {
+	c := e.statusCode()
+	return !e.IsRetryable() && 400 <= c && c <= 499
+}
+
+func (e *ErrorResponse) statusCode() int {
+	if e.Response == nil {
+		return 0
+	}
+	return e.Response.StatusCode
+}
+
+// InactiveRecipientError is the error returned when an email is sent to
+// recipients that were deactivated by a hard bounce, a spam complaint or a
+// manual suppression. It matches ErrInactiveRecipient with errors.Is, and
+// unwraps to the ErrorResponse.
+type InactiveRecipientError struct {
+	*ErrorResponse
+
+	// Addresses are the inactive recipients found in the error message.
+	Addresses []string
+}
+
+// Unwrap returns the underlying ErrorResponse.
+func (e *InactiveRecipientError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// sentenceEnd matches the end of a sentence followed by another one. The dots
+// of the addresses are never followed by whitespace.
+var sentenceEnd = regexp.MustCompile(`\.\s`)
+
+// newInactiveRecipientError parses the addresses out of the message of an
+// inactive recipient error, which looks like:
+//
+//	You tried to send to recipient(s) that have been marked as inactive.
+//	Found inactive addresses: a@example.com, b@example.com.
+//	Inactive recipients are ones that have generated a hard bounce, a spam
+//	complaint, or a manual suppression.
+//
+// The sentences are separated by either spaces or newlines.
+func newInactiveRecipientError(e *ErrorResponse) *InactiveRecipientError {
+	err := &InactiveRecipientError{ErrorResponse: e}
+
+	const prefix = "Found inactive addresses: "
+	i := strings.Index(e.Message, prefix)
+	if i < 0 {
+		return err
+	}
+
+	list := e.Message[i+len(prefix):]
+	if j := strings.IndexByte(list, '\n'); j >= 0 {
+		list = list[:j]
+	}
+	if loc := sentenceEnd.FindStringIndex(list); loc != nil {
+		list = list[:loc[0]]
+	}
+	list = strings.TrimSuffix(strings.TrimSpace(list), ".")
+
+	for _, a := range strings.Split(list, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			err.Addresses = append(err.Addresses, a)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,136 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+var _ = Describe("Errors", func() {
+	// response returns an error response with the status code and body.
+	response := func(code int, body string) *http.Response {
+		return &http.Response{
+			Request:    &http.Request{},
+			StatusCode: code,
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	Describe("Matching an error response", func() {
+		It("should match the sentinel error of its error code", func() {
+			err := CheckResponse(response(422, `{ "ErrorCode": 300, "Message": "Invalid email request" }`))
+			Expect(errors.Is(err, ErrInvalidEmailRequest)).To(BeTrue())
+			Expect(errors.Is(err, ErrInvalidJSON)).To(BeFalse())
+		})
+
+		It("should match wrapped errors", func() {
+			err := CheckResponse(response(401, `{ "ErrorCode": 10, "Message": "Bad or missing API token" }`))
+			err = fmt.Errorf("sending welcome email: %w", err)
+			Expect(errors.Is(err, ErrBadAPIToken)).To(BeTrue())
+
+			var er *ErrorResponse
+			Expect(errors.As(err, &er)).To(BeTrue())
+			Expect(er.ErrorCode).To(Equal(ErrorCodeBadAPIToken))
+		})
+
+		It("should match rate limiting by status code", func() {
+			err := CheckResponse(response(429, ``))
+			Expect(errors.Is(err, ErrRateLimited)).To(BeTrue())
+		})
+
+		It("should not match unknown error codes", func() {
+			err := CheckResponse(response(422, `{ "ErrorCode": 9999, "Message": "Unknown" }`))
+			Expect(errors.Is(err, ErrInvalidEmailRequest)).To(BeFalse())
+		})
+	})
+
+	Describe("Classifying an error response", func() {
+		It("should consider rate limiting and unavailability retryable", func() {
+			for _, code := range []int{429, 503} {
+				err := CheckResponse(response(code, ``)).(*ErrorResponse)
+				Expect(err.IsRetryable()).To(BeTrue())
+				Expect(err.IsPermanent()).To(BeFalse())
+			}
+		})
+
+		It("should not consider other server errors retryable", func() {
+			for _, code := range []int{500, 504} {
+				err := CheckResponse(response(code, ``)).(*ErrorResponse)
+				Expect(err.IsRetryable()).To(BeFalse())
+				Expect(err.IsPermanent()).To(BeFalse())
+			}
+		})
+
+		It("should consider maintenance retryable", func() {
+			err := CheckResponse(response(422, `{ "ErrorCode": 100, "Message": "Maintenance" }`)).(*ErrorResponse)
+			Expect(err.IsRetryable()).To(BeTrue())
+		})
+
+		It("should consider rejected requests permanent", func() {
+			err := CheckResponse(response(422, `{ "ErrorCode": 300, "Message": "Invalid email request" }`)).(*ErrorResponse)
+			Expect(err.IsRetryable()).To(BeFalse())
+			Expect(err.IsPermanent()).To(BeTrue())
+		})
+	})
+
+	Describe("Sending to an inactive recipient", func() {
+		var env *testEnv
+
+		BeforeEach(func() {
+			env = newTestEnv()
+			env.Mux.HandleFunc("/email", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(422)
+				fmt.Fprintf(w, `{
+					"ErrorCode": 406,
+					"Message": "You tried to send to recipient(s) that have been marked as inactive. Found inactive addresses: john.doe@example.com, jane.doe@example.com. Inactive recipients are ones that have generated a hard bounce, a spam complaint, or a manual suppression."
+				}`)
+			})
+		})
+
+		AfterEach(func() {
+			env.StopServer()
+		})
+
+		It("should return the inactive addresses", func() {
+			_, _, err := env.Client.Email.Send(context.Background(), &Email{})
+
+			var inactive *InactiveRecipientError
+			Expect(errors.As(err, &inactive)).To(BeTrue())
+			Expect(inactive.Addresses).To(Equal([]string{"john.doe@example.com", "jane.doe@example.com"}))
+		})
+
+		It("should match the inactive recipient error and unwrap to the error response", func() {
+			_, _, err := env.Client.Email.Send(context.Background(), &Email{})
+			Expect(errors.Is(err, ErrInactiveRecipient)).To(BeTrue())
+
+			var er *ErrorResponse
+			Expect(errors.As(err, &er)).To(BeTrue())
+			Expect(er.ErrorCode).To(Equal(ErrorCodeInactiveRecipient))
+			Expect(er.IsPermanent()).To(BeTrue())
+		})
+
+		It("should handle a message with a single address", func() {
+			err := CheckResponse(response(422, `{
+				"ErrorCode": 406,
+				"Message": "Found inactive addresses: john.doe@example.com."
+			}`))
+			Expect(err.(*InactiveRecipientError).Addresses).To(Equal([]string{"john.doe@example.com"}))
+		})
+
+		It("should handle a message with a sentence per line", func() {
+			err := CheckResponse(response(422, `{
+				"ErrorCode": 406,
+				"Message": "You tried to send to recipient(s) that have been marked as inactive.\nFound inactive addresses: a@example.com, b@example.com.\nInactive recipients are ones that have generated a hard bounce, a spam complaint, or a manual suppression."
+			}`))
+			Expect(err.(*InactiveRecipientError).Addresses).To(Equal([]string{"a@example.com", "b@example.com"}))
+		})
+	})
+})
@@ -0,0 +1,109 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// InboundRuleService handles communication with the Inbound Rules Triggers
+// related methods of the Postmark API.
+type InboundRuleService struct {
+	client *Client
+}
+
+// InboundRule blocks inbound messages from an email address or, when the
+// rule is a domain, from every address of that domain.
+type InboundRule struct {
+	ID   int64
+	Rule string
+}
+
+// InboundRuleList is a page of inbound rules returned by
+// InboundRuleService.List.
+type InboundRuleList struct {
+	TotalCount   int
+	InboundRules []InboundRule
+}
+
+// InboundRuleListOptions specifies the optional parameters to the
+// InboundRuleService.List method.
+type InboundRuleListOptions struct {
+	ListOptions
+}
+
+// inboundRuleRequest is the request body of the inbound rule creation
+// endpoint.
+type inboundRuleRequest struct {
+	Rule string
+}
+
+// List returns the inbound rules of the server.
+func (s *InboundRuleService) List(ctx context.Context, opt *InboundRuleListOptions) (*InboundRuleList, *http.Response, error) {
+	u, err := addOptions("triggers/inboundrules", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rules := new(InboundRuleList)
+	resp, err := s.client.Do(req, rules)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rules, resp, err
+}
+
+// ListAll returns an iterator over all the inbound rules of the server. See
+// Paginate.
+func (s *InboundRuleService) ListAll(ctx context.Context, opt *InboundRuleListOptions) iter.Seq2[InboundRule, error] {
+	var base InboundRuleListOptions
+	if opt != nil {
+		base = *opt
+	}
+
+	return Paginate(ctx, &base.ListOptions, func(ctx context.Context, page ListOptions) ([]InboundRule, int, error) {
+		o := base
+		o.ListOptions = page
+
+		list, _, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.InboundRules, list.TotalCount, nil
+	})
+}
+
+// Create blocks inbound messages from rule, which is either an email address
+// or a domain.
+func (s *InboundRuleService) Create(ctx context.Context, rule string) (*InboundRule, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "POST", "triggers/inboundrules", &inboundRuleRequest{Rule: rule})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(InboundRule)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+// Delete deletes an inbound rule.
+func (s *InboundRuleService) Delete(ctx context.Context, id int64) (*http.Response, error) {
+	u := fmt.Sprintf("triggers/inboundrules/%d", id)
+	req, err := s.client.newServerRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,80 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+var _ = Describe("InboundRule", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	Describe("Listing inbound rules", func() {
+		It("should paginate with count and offset", func() {
+			env.Mux.HandleFunc("/triggers/inboundrules", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				env.assertServerHeaders(r)
+
+				q := r.URL.Query()
+				Expect(q.Get("count")).To(Equal("10"))
+				Expect(q.Get("offset")).To(Equal("20"))
+
+				fmt.Fprintf(w, `{
+					"TotalCount": 21,
+					"InboundRules": [{ "ID": 3, "Rule": "someone@example.com" }]
+				}`)
+			})
+
+			rules, _, err := env.Client.InboundRules.List(context.Background(), &InboundRuleListOptions{
+				ListOptions: ListOptions{Count: 10, Offset: 20},
+			})
+			Expect(err).To(BeNil())
+			Expect(rules).To(Equal(&InboundRuleList{
+				TotalCount:   21,
+				InboundRules: []InboundRule{{ID: 3, Rule: "someone@example.com"}},
+			}))
+		})
+	})
+
+	Describe("Creating an inbound rule", func() {
+		It("should post the rule", func() {
+			env.Mux.HandleFunc("/triggers/inboundrules", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{ "Rule": "spammer.com" }`))
+				fmt.Fprintf(w, `{ "ID": 15, "Rule": "spammer.com" }`)
+			})
+
+			rule, _, err := env.Client.InboundRules.Create(context.Background(), "spammer.com")
+			Expect(err).To(BeNil())
+			Expect(rule).To(Equal(&InboundRule{ID: 15, Rule: "spammer.com"}))
+		})
+	})
+
+	Describe("Deleting an inbound rule", func() {
+		It("should send a delete request", func() {
+			env.Mux.HandleFunc("/triggers/inboundrules/15", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("DELETE"))
+				env.assertServerHeaders(r)
+				fmt.Fprintf(w, `{ "ErrorCode": 0, "Message": "Rule spammer.com removed." }`)
+			})
+
+			_, err := env.Client.InboundRules.Delete(context.Background(), 15)
+			Expect(err).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,563 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// MessageService handles communication with the Messages related
+// methods of the Postmark API.
+type MessageService struct {
+	client *Client
+}
+
+// MessageAddress is a recipient or sender of a message.
+type MessageAddress struct {
+	Email string
+	Name  string
+}
+
+// OutboundMessage represents a message sent through the server.
+type OutboundMessage struct {
+	MessageID     string
+	MessageStream string
+	Tag           string
+	From          string
+	To            []MessageAddress
+	Cc            []MessageAddress
+	Bcc           []MessageAddress
+	Recipients    []string
+	ReceivedAt    *time.Time
+	Subject       string
+	Status        string
+	TrackOpens    bool
+	TrackLinks    string
+	Metadata      map[string]string
+	Sandboxed     bool
+}
+
+// OutboundMessageDetails is an outbound message along with its content and
+// the events that happened to it after it was sent.
+type OutboundMessageDetails struct {
+	OutboundMessage
+
+	TextBody      string
+	HTMLBody      string `json:"HtmlBody"`
+	Body          string
+	MessageEvents []MessageEvent
+}
+
+// MessageEvent is a single event in the timeline of an outbound message,
+// such as "Delivered", "Opened", "LinkClicked" or "Bounced".
+type MessageEvent struct {
+	Recipient  string
+	Type       string
+	ReceivedAt *time.Time
+	Details    map[string]interface{}
+}
+
+// OutboundMessageList is a page of messages returned by
+// MessageService.ListOutbound.
+type OutboundMessageList struct {
+	TotalCount int
+	Messages   []OutboundMessage
+}
+
+// OutboundMessageListOptions specifies the optional parameters to the
+// MessageService.ListOutbound method.
+type OutboundMessageListOptions struct {
+	ListOptions
+
+	Recipient string `url:"recipient,omitempty"`
+	FromEmail string `url:"fromemail,omitempty"`
+	Tag       string `url:"tag,omitempty"`
+	Subject   string `url:"subject,omitempty"`
+
+	// Status filters by message status, either "queued" or "sent".
+	Status string `url:"status,omitempty"`
+
+	// FromDate and ToDate filter messages by date, in the form YYYY-MM-DD.
+	FromDate string `url:"fromdate,omitempty"`
+	ToDate   string `url:"todate,omitempty"`
+
+	MessageStream string `url:"messagestream,omitempty"`
+
+	// Metadata filters by the metadata attached to messages when they were
+	// sent.
+	Metadata map[string]string `url:"-"`
+}
+
+// addMetadataOptions adds the metadata filters to s as URL query parameters,
+// using the "metadata_" prefixed keys expected by the Postmark API.
+func addMetadataOptions(s string, metadata map[string]string) (string, error) {
+	if len(metadata) == 0 {
+		return s, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return s, err
+	}
+
+	qs := u.Query()
+	for k, v := range metadata {
+		qs.Set("metadata_"+k, v)
+	}
+
+	u.RawQuery = qs.Encode()
+	return u.String(), nil
+}
+
+// ListOutbound searches the messages sent through the server.
+func (s *MessageService) ListOutbound(ctx context.Context, opt *OutboundMessageListOptions) (*OutboundMessageList, *http.Response, error) {
+	u, err := addOptions("messages/outbound", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opt != nil {
+		u, err = addMetadataOptions(u, opt.Metadata)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	messages := new(OutboundMessageList)
+	resp, err := s.client.Do(req, messages)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return messages, resp, err
+}
+
+// ListAllOutbound returns an iterator over all the sent messages matching
+// the search of opt, such as its recipient, tag or metadata filters. See
+// Paginate.
+func (s *MessageService) ListAllOutbound(ctx context.Context, opt *OutboundMessageListOptions) iter.Seq2[OutboundMessage, error] {
+	var base OutboundMessageListOptions
+	if opt != nil {
+		base = *opt
+	}
+
+	return Paginate(ctx, &base.ListOptions, func(ctx context.Context, page ListOptions) ([]OutboundMessage, int, error) {
+		o := base
+		o.ListOptions = page
+
+		list, _, err := s.ListOutbound(ctx, &o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Messages, list.TotalCount, nil
+	})
+}
+
+// GetOutboundDetails returns the content and event timeline of a single
+// outbound message.
+func (s *MessageService) GetOutboundDetails(ctx context.Context, messageID string) (*OutboundMessageDetails, *http.Response, error) {
+	u := fmt.Sprintf("messages/outbound/%s/details", messageID)
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	details := new(OutboundMessageDetails)
+	resp, err := s.client.Do(req, details)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return details, resp, err
+}
+
+// GetOutboundDump returns the raw MIME source of an outbound message. The
+// dump is empty if it is no longer available.
+func (s *MessageService) GetOutboundDump(ctx context.Context, messageID string) (string, *http.Response, error) {
+	u := fmt.Sprintf("messages/outbound/%s/dump", messageID)
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	d := new(dump)
+	resp, err := s.client.Do(req, d)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return d.Body, resp, err
+}
+
+// InboundAddress is a recipient or sender of an inbound message.
+type InboundAddress struct {
+	Email       string
+	Name        string
+	MailboxHash string
+}
+
+// InboundAttachment describes a file attached to an inbound message.
+type InboundAttachment struct {
+	Name          string
+	ContentType   string
+	ContentID     string
+	ContentLength int
+}
+
+// InboundMessage represents a message received by the server's inbound
+// address. The body and headers are only included when fetching the details
+// of a single message.
+type InboundMessage struct {
+	MessageID         string
+	MessageStream     string
+	From              string
+	FromName          string
+	FromFull          *InboundAddress
+	To                string
+	ToFull            []InboundAddress
+	Cc                string
+	CcFull            []InboundAddress
+	ReplyTo           string
+	OriginalRecipient string
+	Subject           string
+	Date              string
+	MailboxHash       string
+	Tag               string
+	Status            string
+	Attachments       []InboundAttachment
+
+	TextBody          string
+	HTMLBody          string `json:"HtmlBody"`
+	StrippedTextReply string
+	Headers           []Header
+	BlockedReason     string
+}
+
+// InboundMessageList is a page of messages returned by
+// MessageService.ListInbound.
+type InboundMessageList struct {
+	TotalCount      int
+	InboundMessages []InboundMessage
+}
+
+// InboundMessageListOptions specifies the optional parameters to the
+// MessageService.ListInbound method.
+type InboundMessageListOptions struct {
+	ListOptions
+
+	Recipient   string `url:"recipient,omitempty"`
+	FromEmail   string `url:"fromemail,omitempty"`
+	Tag         string `url:"tag,omitempty"`
+	Subject     string `url:"subject,omitempty"`
+	MailboxHash string `url:"mailboxhash,omitempty"`
+
+	// Status filters by message status, such as "blocked", "processed",
+	// "queued", "failed" or "scheduled".
+	Status string `url:"status,omitempty"`
+
+	// FromDate and ToDate filter messages by date, in the form YYYY-MM-DD.
+	FromDate string `url:"fromdate,omitempty"`
+	ToDate   string `url:"todate,omitempty"`
+}
+
+// ListInbound searches the messages received by the server.
+func (s *MessageService) ListInbound(ctx context.Context, opt *InboundMessageListOptions) (*InboundMessageList, *http.Response, error) {
+	u, err := addOptions("messages/inbound", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	messages := new(InboundMessageList)
+	resp, err := s.client.Do(req, messages)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return messages, resp, err
+}
+
+// ListAllInbound returns an iterator over all the received messages matching
+// the search of opt, such as its mailbox hash or status filters. See
+// Paginate.
+func (s *MessageService) ListAllInbound(ctx context.Context, opt *InboundMessageListOptions) iter.Seq2[InboundMessage, error] {
+	var base InboundMessageListOptions
+	if opt != nil {
+		base = *opt
+	}
+
+	return Paginate(ctx, &base.ListOptions, func(ctx context.Context, page ListOptions) ([]InboundMessage, int, error) {
+		o := base
+		o.ListOptions = page
+
+		list, _, err := s.ListInbound(ctx, &o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.InboundMessages, list.TotalCount, nil
+	})
+}
+
+// GetInboundDetails returns a single inbound message including its content,
+// parsed headers and attachments.
+func (s *MessageService) GetInboundDetails(ctx context.Context, messageID string) (*InboundMessage, *http.Response, error) {
+	u := fmt.Sprintf("messages/inbound/%s/details", messageID)
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	message := new(InboundMessage)
+	resp, err := s.client.Do(req, message)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return message, resp, err
+}
+
+// BypassInboundRules processes an inbound message that was blocked by the
+// server's inbound rules.
+func (s *MessageService) BypassInboundRules(ctx context.Context, messageID string) (*http.Response, error) {
+	u := fmt.Sprintf("messages/inbound/%s/bypass", messageID)
+	req, err := s.client.newServerRequest(ctx, "PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// RetryInbound reschedules an inbound message that failed to be processed.
+func (s *MessageService) RetryInbound(ctx context.Context, messageID string) (*http.Response, error) {
+	u := fmt.Sprintf("messages/inbound/%s/retry", messageID)
+	req, err := s.client.newServerRequest(ctx, "PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// UserAgentInfo describes a component of the user agent that opened a
+// message or clicked a link, such as the email client or operating system.
+type UserAgentInfo struct {
+	Name    string
+	Company string
+	Family  string
+}
+
+// GeoInfo describes the location a message was opened or a link was clicked
+// from, as resolved from the IP address.
+type GeoInfo struct {
+	CountryISOCode string
+	Country        string
+	RegionISOCode  string
+	Region         string
+	City           string
+	Zip            string
+	Coords         string
+	IP             string
+}
+
+// MessageOpen is a single open of a message with open tracking enabled.
+type MessageOpen struct {
+	RecordType    string
+	MessageID     string
+	MessageStream string
+	Recipient     string
+	Tag           string
+	ReceivedAt    *time.Time
+	FirstOpen     bool
+	ReadSeconds   int
+	Client        UserAgentInfo
+	OS            UserAgentInfo
+	Platform      string
+	UserAgent     string
+	Geo           GeoInfo
+	Metadata      map[string]string
+}
+
+// MessageClick is a single click of a link in a message with link tracking
+// enabled.
+type MessageClick struct {
+	RecordType    string
+	MessageID     string
+	MessageStream string
+	Recipient     string
+	Tag           string
+	ReceivedAt    *time.Time
+	ClickLocation string
+	OriginalLink  string
+	Client        UserAgentInfo
+	OS            UserAgentInfo
+	Platform      string
+	UserAgent     string
+	Geo           GeoInfo
+	Metadata      map[string]string
+}
+
+// MessageOpenList is a page of opens returned by MessageService.ListOpens
+// and MessageService.ListMessageOpens.
+type MessageOpenList struct {
+	TotalCount int
+	Opens      []MessageOpen
+}
+
+// MessageClickList is a page of clicks returned by MessageService.ListClicks
+// and MessageService.ListMessageClicks.
+type MessageClickList struct {
+	TotalCount int
+	Clicks     []MessageClick
+}
+
+// TrackingListOptions specifies the optional parameters to the
+// MessageService.ListOpens and MessageService.ListClicks methods.
+type TrackingListOptions struct {
+	ListOptions
+
+	Recipient string `url:"recipient,omitempty"`
+	Tag       string `url:"tag,omitempty"`
+
+	ClientName    string `url:"client_name,omitempty"`
+	ClientCompany string `url:"client_company,omitempty"`
+	ClientFamily  string `url:"client_family,omitempty"`
+	OSName        string `url:"os_name,omitempty"`
+	OSFamily      string `url:"os_family,omitempty"`
+	OSCompany     string `url:"os_company,omitempty"`
+
+	// Platform filters by platform, such as "webmail", "desktop" or
+	// "mobile".
+	Platform string `url:"platform,omitempty"`
+
+	Country string `url:"country,omitempty"`
+	Region  string `url:"region,omitempty"`
+	City    string `url:"city,omitempty"`
+
+	MessageStream string `url:"messagestream,omitempty"`
+}
+
+// ListOpens returns the opens of all messages sent through the server.
+func (s *MessageService) ListOpens(ctx context.Context, opt *TrackingListOptions) (*MessageOpenList, *http.Response, error) {
+	u, err := addOptions("messages/outbound/opens", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.listOpens(ctx, u)
+}
+
+// ListAllOpens returns an iterator over all the opens recorded by open
+// tracking that match the filters of opt. See Paginate.
+func (s *MessageService) ListAllOpens(ctx context.Context, opt *TrackingListOptions) iter.Seq2[MessageOpen, error] {
+	var base TrackingListOptions
+	if opt != nil {
+		base = *opt
+	}
+
+	return Paginate(ctx, &base.ListOptions, func(ctx context.Context, page ListOptions) ([]MessageOpen, int, error) {
+		o := base
+		o.ListOptions = page
+
+		list, _, err := s.ListOpens(ctx, &o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Opens, list.TotalCount, nil
+	})
+}
+
+// ListMessageOpens returns the opens of a single message.
+func (s *MessageService) ListMessageOpens(ctx context.Context, messageID string, opt *ListOptions) (*MessageOpenList, *http.Response, error) {
+	u, err := addOptions(fmt.Sprintf("messages/outbound/opens/%s", messageID), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.listOpens(ctx, u)
+}
+
+// listOpens fetches a page of opens from the already encoded URL u.
+func (s *MessageService) listOpens(ctx context.Context, u string) (*MessageOpenList, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opens := new(MessageOpenList)
+	resp, err := s.client.Do(req, opens)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return opens, resp, err
+}
+
+// ListClicks returns the link clicks of all messages sent through the
+// server.
+func (s *MessageService) ListClicks(ctx context.Context, opt *TrackingListOptions) (*MessageClickList, *http.Response, error) {
+	u, err := addOptions("messages/outbound/clicks", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.listClicks(ctx, u)
+}
+
+// ListAllClicks returns an iterator over all the clicks recorded by link
+// tracking that match the filters of opt. See Paginate.
+func (s *MessageService) ListAllClicks(ctx context.Context, opt *TrackingListOptions) iter.Seq2[MessageClick, error] {
+	var base TrackingListOptions
+	if opt != nil {
+		base = *opt
+	}
+
+	return Paginate(ctx, &base.ListOptions, func(ctx context.Context, page ListOptions) ([]MessageClick, int, error) {
+		o := base
+		o.ListOptions = page
+
+		list, _, err := s.ListClicks(ctx, &o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Clicks, list.TotalCount, nil
+	})
+}
+
+// ListMessageClicks returns the link clicks of a single message.
+func (s *MessageService) ListMessageClicks(ctx context.Context, messageID string, opt *ListOptions) (*MessageClickList, *http.Response, error) {
+	u, err := addOptions(fmt.Sprintf("messages/outbound/clicks/%s", messageID), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.listClicks(ctx, u)
+}
+
+// listClicks fetches a page of clicks from the already encoded URL u.
+func (s *MessageService) listClicks(ctx context.Context, u string) (*MessageClickList, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clicks := new(MessageClickList)
+	resp, err := s.client.Do(req, clicks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return clicks, resp, err
+}
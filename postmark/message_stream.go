@@ -0,0 +1,200 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// MessageStreamService handles communication with the Message Streams
+// related methods of the Postmark API.
+type MessageStreamService struct {
+	client *Client
+}
+
+// MessageStreamType is the kind of traffic a message stream carries.
+type MessageStreamType string
+
+// The message stream types supported by the Postmark API.
+const (
+	MessageStreamTransactional MessageStreamType = "Transactional"
+	MessageStreamBroadcasts    MessageStreamType = "Broadcasts"
+	MessageStreamInbound       MessageStreamType = "Inbound"
+)
+
+// UnsubscribeHandlingType is how unsubscribe requests for a message stream
+// are handled.
+type UnsubscribeHandlingType string
+
+// The unsubscribe handling types supported by the Postmark API.
+const (
+	// UnsubscribeHandlingNone disables unsubscribe handling. It is only
+	// allowed for transactional streams.
+	UnsubscribeHandlingNone UnsubscribeHandlingType = "None"
+
+	// UnsubscribeHandlingPostmark adds a Postmark hosted unsubscribe link and
+	// List-Unsubscribe header to messages.
+	UnsubscribeHandlingPostmark UnsubscribeHandlingType = "Postmark"
+
+	// UnsubscribeHandlingCustom leaves unsubscribe handling to the sender.
+	UnsubscribeHandlingCustom UnsubscribeHandlingType = "Custom"
+)
+
+// SubscriptionManagementConfiguration holds the unsubscribe settings of a
+// message stream.
+type SubscriptionManagementConfiguration struct {
+	UnsubscribeHandlingType *UnsubscribeHandlingType `json:"UnsubscribeHandlingType,omitempty"`
+}
+
+// MessageStream represents a message stream of a server. The same type is
+// used to create and edit streams, in which case nil fields are left
+// unchanged. The ID and MessageStreamType can only be set when creating a
+// stream.
+type MessageStream struct {
+	ID                *string            `json:"ID,omitempty"`
+	ServerID          *int64             `json:"ServerID,omitempty"`
+	Name              *string            `json:"Name,omitempty"`
+	Description       *string            `json:"Description,omitempty"`
+	MessageStreamType *MessageStreamType `json:"MessageStreamType,omitempty"`
+	CreatedAt         *time.Time         `json:"CreatedAt,omitempty"`
+	UpdatedAt         *time.Time         `json:"UpdatedAt,omitempty"`
+	ArchivedAt        *time.Time         `json:"ArchivedAt,omitempty"`
+	ExpectedPurgeDate *time.Time         `json:"ExpectedPurgeDate,omitempty"`
+
+	SubscriptionManagementConfiguration *SubscriptionManagementConfiguration `json:"SubscriptionManagementConfiguration,omitempty"`
+}
+
+// MessageStreamList is the list of streams returned by
+// MessageStreamService.List.
+type MessageStreamList struct {
+	TotalCount     int
+	MessageStreams []MessageStream
+}
+
+// MessageStreamListOptions specifies the optional parameters to the
+// MessageStreamService.List method.
+type MessageStreamListOptions struct {
+	// MessageStreamType filters by stream type. All types are returned when
+	// empty.
+	MessageStreamType MessageStreamType `url:"MessageStreamType,omitempty"`
+
+	IncludeArchivedStreams bool `url:"IncludeArchivedStreams,omitempty"`
+}
+
+// MessageStreamArchival is returned when a message stream is archived.
+type MessageStreamArchival struct {
+	ID       string
+	ServerID int64
+
+	// ExpectedPurgeDate is when the stream and its data will be deleted,
+	// unless it is unarchived before then.
+	ExpectedPurgeDate *time.Time
+}
+
+// List returns the message streams of the server.
+func (s *MessageStreamService) List(ctx context.Context, opt *MessageStreamListOptions) (*MessageStreamList, *http.Response, error) {
+	u, err := addOptions("message-streams", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	streams := new(MessageStreamList)
+	resp, err := s.client.Do(req, streams)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return streams, resp, err
+}
+
+// Get returns a single message stream.
+func (s *MessageStreamService) Get(ctx context.Context, id string) (*MessageStream, *http.Response, error) {
+	u := fmt.Sprintf("message-streams/%s", id)
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream := new(MessageStream)
+	resp, err := s.client.Do(req, stream)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stream, resp, err
+}
+
+// Create creates a new message stream.
+func (s *MessageStreamService) Create(ctx context.Context, stream *MessageStream) (*MessageStream, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "POST", "message-streams", stream)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ms := new(MessageStream)
+	resp, err := s.client.Do(req, ms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ms, resp, err
+}
+
+// Edit updates the name, description or unsubscribe settings of a message
+// stream.
+func (s *MessageStreamService) Edit(ctx context.Context, id string, stream *MessageStream) (*MessageStream, *http.Response, error) {
+	u := fmt.Sprintf("message-streams/%s", id)
+	req, err := s.client.newServerRequest(ctx, "PATCH", u, stream)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ms := new(MessageStream)
+	resp, err := s.client.Do(req, ms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ms, resp, err
+}
+
+// Archive archives a message stream. Archived streams are purged after a
+// while unless they are unarchived.
+func (s *MessageStreamService) Archive(ctx context.Context, id string) (*MessageStreamArchival, *http.Response, error) {
+	u := fmt.Sprintf("message-streams/%s/archive", id)
+	req, err := s.client.newServerRequest(ctx, "POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	archival := new(MessageStreamArchival)
+	resp, err := s.client.Do(req, archival)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return archival, resp, err
+}
+
+// Unarchive restores an archived message stream.
+func (s *MessageStreamService) Unarchive(ctx context.Context, id string) (*MessageStream, *http.Response, error) {
+	u := fmt.Sprintf("message-streams/%s/unarchive", id)
+	req, err := s.client.newServerRequest(ctx, "POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stream := new(MessageStream)
+	resp, err := s.client.Do(req, stream)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stream, resp, err
+}
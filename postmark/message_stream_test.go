@@ -0,0 +1,161 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+var _ = Describe("MessageStream", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	Describe("Listing message streams", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/message-streams", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"MessageStreams": [{
+						"ID": "broadcasts",
+						"ServerID": 123457,
+						"Name": "Broadcast Stream",
+						"MessageStreamType": "Broadcasts",
+						"ArchivedAt": null,
+						"SubscriptionManagementConfiguration": {
+							"UnsubscribeHandlingType": "Postmark"
+						}
+					}],
+					"TotalCount": 1
+				}`)
+			})
+		})
+
+		It("should encode the filters as query parameters", func() {
+			_, resp, err := env.Client.MessageStreams.List(context.Background(), &MessageStreamListOptions{
+				MessageStreamType:      MessageStreamBroadcasts,
+				IncludeArchivedStreams: true,
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Request.Method).To(Equal("GET"))
+			env.assertServerHeaders(resp.Request)
+
+			q := resp.Request.URL.Query()
+			Expect(q.Get("MessageStreamType")).To(Equal("Broadcasts"))
+			Expect(q.Get("IncludeArchivedStreams")).To(Equal("true"))
+		})
+
+		It("should return the correct streams", func() {
+			streams, _, _ := env.Client.MessageStreams.List(context.Background(), nil)
+			streamType := MessageStreamBroadcasts
+			handling := UnsubscribeHandlingPostmark
+			Expect(streams).To(Equal(&MessageStreamList{
+				TotalCount: 1,
+				MessageStreams: []MessageStream{{
+					ID:                String("broadcasts"),
+					ServerID:          Int64(123457),
+					Name:              String("Broadcast Stream"),
+					MessageStreamType: &streamType,
+					SubscriptionManagementConfiguration: &SubscriptionManagementConfiguration{
+						UnsubscribeHandlingType: &handling,
+					},
+				}},
+			}))
+		})
+	})
+
+	Describe("Getting a message stream", func() {
+		It("should return the stream", func() {
+			env.Mux.HandleFunc("/message-streams/outbound", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				fmt.Fprintf(w, `{ "ID": "outbound", "MessageStreamType": "Transactional" }`)
+			})
+
+			stream, _, err := env.Client.MessageStreams.Get(context.Background(), "outbound")
+			Expect(err).To(BeNil())
+			Expect(*stream.MessageStreamType).To(Equal(MessageStreamTransactional))
+		})
+	})
+
+	Describe("Creating a message stream", func() {
+		It("should post the stream", func() {
+			env.Mux.HandleFunc("/message-streams", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{
+					"ID": "newsletter",
+					"Name": "Newsletter",
+					"MessageStreamType": "Broadcasts"
+				}`))
+				fmt.Fprintf(w, `{ "ID": "newsletter" }`)
+			})
+
+			streamType := MessageStreamBroadcasts
+			_, _, err := env.Client.MessageStreams.Create(context.Background(), &MessageStream{
+				ID:                String("newsletter"),
+				Name:              String("Newsletter"),
+				MessageStreamType: &streamType,
+			})
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Editing a message stream", func() {
+		It("should patch the stream", func() {
+			env.Mux.HandleFunc("/message-streams/newsletter", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PATCH"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{ "Description": "Weekly" }`))
+				fmt.Fprintf(w, `{ "ID": "newsletter", "Description": "Weekly" }`)
+			})
+
+			stream, _, err := env.Client.MessageStreams.Edit(context.Background(), "newsletter", &MessageStream{
+				Description: String("Weekly"),
+			})
+			Expect(err).To(BeNil())
+			Expect(*stream.Description).To(Equal("Weekly"))
+		})
+	})
+
+	Describe("Archiving a message stream", func() {
+		It("should return the expected purge date", func() {
+			env.Mux.HandleFunc("/message-streams/newsletter/archive", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				fmt.Fprintf(w, `{
+					"ID": "newsletter",
+					"ServerID": 123457,
+					"ExpectedPurgeDate": "2020-08-30T12:30:00.00-04:00"
+				}`)
+			})
+
+			archival, _, err := env.Client.MessageStreams.Archive(context.Background(), "newsletter")
+			Expect(err).To(BeNil())
+			Expect(archival.ID).To(Equal("newsletter"))
+			Expect(archival.ExpectedPurgeDate).NotTo(BeNil())
+		})
+	})
+
+	Describe("Unarchiving a message stream", func() {
+		It("should return the restored stream", func() {
+			env.Mux.HandleFunc("/message-streams/newsletter/unarchive", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				fmt.Fprintf(w, `{ "ID": "newsletter", "ArchivedAt": null }`)
+			})
+
+			stream, _, err := env.Client.MessageStreams.Unarchive(context.Background(), "newsletter")
+			Expect(err).To(BeNil())
+			Expect(stream.ArchivedAt).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,342 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"fmt"
+	"net/http"
+)
+
+var _ = Describe("Message", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	Describe("Searching outbound messages", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/messages/outbound", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"TotalCount": 1,
+					"Messages": [{
+						"Tag": "Invitation",
+						"MessageID": "0ac29aee-e1cd-480d-b08d-4f48548ff48d",
+						"To": [{ "Email": "john.doe@yahoo.com", "Name": null }],
+						"Recipients": ["john.doe@yahoo.com"],
+						"Subject": "Parts Order #5454",
+						"Status": "Sent",
+						"Metadata": { "color": "blue" }
+					}]
+				}`)
+			})
+		})
+
+		It("should encode the filters and metadata as query parameters", func() {
+			_, resp, err := env.Client.Messages.ListOutbound(context.Background(), &OutboundMessageListOptions{
+				ListOptions: ListOptions{Count: 50},
+				Recipient:   "john.doe@yahoo.com",
+				Status:      "sent",
+				Metadata:    map[string]string{"color": "blue"},
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Request.Method).To(Equal("GET"))
+			Expect(resp.Request.URL.Path).To(Equal("/messages/outbound"))
+			env.assertServerHeaders(resp.Request)
+
+			q := resp.Request.URL.Query()
+			Expect(q.Get("count")).To(Equal("50"))
+			Expect(q.Get("recipient")).To(Equal("john.doe@yahoo.com"))
+			Expect(q.Get("status")).To(Equal("sent"))
+			Expect(q.Get("metadata_color")).To(Equal("blue"))
+		})
+
+		It("should return the correct messages", func() {
+			messages, _, _ := env.Client.Messages.ListOutbound(context.Background(), &OutboundMessageListOptions{})
+			Expect(messages).To(Equal(&OutboundMessageList{
+				TotalCount: 1,
+				Messages: []OutboundMessage{{
+					Tag:        "Invitation",
+					MessageID:  "0ac29aee-e1cd-480d-b08d-4f48548ff48d",
+					To:         []MessageAddress{{Email: "john.doe@yahoo.com"}},
+					Recipients: []string{"john.doe@yahoo.com"},
+					Subject:    "Parts Order #5454",
+					Status:     "Sent",
+					Metadata:   map[string]string{"color": "blue"},
+				}},
+			}))
+		})
+	})
+
+	Describe("Getting outbound message details", func() {
+		It("should return the message content and events", func() {
+			env.Mux.HandleFunc("/messages/outbound/MessageID/details", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				fmt.Fprintf(w, `{
+					"MessageID": "MessageID",
+					"TextBody": "Body",
+					"MessageEvents": [{
+						"Recipient": "john.doe@yahoo.com",
+						"Type": "Delivered",
+						"Details": { "DeliveryMessage": "OK" }
+					}]
+				}`)
+			})
+
+			details, _, err := env.Client.Messages.GetOutboundDetails(context.Background(), "MessageID")
+			Expect(err).To(BeNil())
+			Expect(details.MessageID).To(Equal("MessageID"))
+			Expect(details.TextBody).To(Equal("Body"))
+			Expect(details.MessageEvents).To(Equal([]MessageEvent{{
+				Recipient: "john.doe@yahoo.com",
+				Type:      "Delivered",
+				Details:   map[string]interface{}{"DeliveryMessage": "OK"},
+			}}))
+		})
+	})
+
+	Describe("Getting an outbound message dump", func() {
+		It("should return the dump body", func() {
+			env.Mux.HandleFunc("/messages/outbound/MessageID/dump", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{ "Body": "From: sender@example.com" }`)
+			})
+
+			dump, _, err := env.Client.Messages.GetOutboundDump(context.Background(), "MessageID")
+			Expect(err).To(BeNil())
+			Expect(dump).To(Equal("From: sender@example.com"))
+		})
+	})
+
+	Describe("Searching inbound messages", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/messages/inbound", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"TotalCount": 1,
+					"InboundMessages": [{
+						"From": "anything@example.com",
+						"FromFull": { "Email": "anything@example.com", "Name": "Anything" },
+						"Subject": "Re: Help",
+						"MailboxHash": "ahoy",
+						"MessageID": "cc5a8d7a-0e6c-4bdb-8d0e-f1e2a6c1f3a2",
+						"Status": "Processed",
+						"Attachments": [{
+							"Name": "file.pdf",
+							"ContentType": "application/pdf",
+							"ContentLength": 4096
+						}]
+					}]
+				}`)
+			})
+		})
+
+		It("should encode the filters as query parameters", func() {
+			_, resp, err := env.Client.Messages.ListInbound(context.Background(), &InboundMessageListOptions{
+				ListOptions: ListOptions{Count: 50},
+				MailboxHash: "ahoy",
+				Status:      "processed",
+				Subject:     "Help",
+				FromDate:    "2014-02-01",
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Request.Method).To(Equal("GET"))
+			Expect(resp.Request.URL.Path).To(Equal("/messages/inbound"))
+			env.assertServerHeaders(resp.Request)
+
+			q := resp.Request.URL.Query()
+			Expect(q.Get("mailboxhash")).To(Equal("ahoy"))
+			Expect(q.Get("status")).To(Equal("processed"))
+			Expect(q.Get("subject")).To(Equal("Help"))
+			Expect(q.Get("fromdate")).To(Equal("2014-02-01"))
+		})
+
+		It("should return the correct messages", func() {
+			messages, _, _ := env.Client.Messages.ListInbound(context.Background(), &InboundMessageListOptions{})
+			Expect(messages).To(Equal(&InboundMessageList{
+				TotalCount: 1,
+				InboundMessages: []InboundMessage{{
+					From:        "anything@example.com",
+					FromFull:    &InboundAddress{Email: "anything@example.com", Name: "Anything"},
+					Subject:     "Re: Help",
+					MailboxHash: "ahoy",
+					MessageID:   "cc5a8d7a-0e6c-4bdb-8d0e-f1e2a6c1f3a2",
+					Status:      "Processed",
+					Attachments: []InboundAttachment{{
+						Name:          "file.pdf",
+						ContentType:   "application/pdf",
+						ContentLength: 4096,
+					}},
+				}},
+			}))
+		})
+	})
+
+	Describe("Getting inbound message details", func() {
+		It("should return the message with its headers", func() {
+			env.Mux.HandleFunc("/messages/inbound/MessageID/details", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				fmt.Fprintf(w, `{
+					"MessageID": "MessageID",
+					"TextBody": "Body",
+					"StrippedTextReply": "Reply",
+					"Headers": [{ "Name": "X-Spam-Status", "Value": "No" }]
+				}`)
+			})
+
+			message, _, err := env.Client.Messages.GetInboundDetails(context.Background(), "MessageID")
+			Expect(err).To(BeNil())
+			Expect(message).To(Equal(&InboundMessage{
+				MessageID:         "MessageID",
+				TextBody:          "Body",
+				StrippedTextReply: "Reply",
+				Headers:           []Header{{Name: String("X-Spam-Status"), Value: String("No")}},
+			}))
+		})
+	})
+
+	Describe("Bypassing inbound rules", func() {
+		It("should put to the bypass endpoint", func() {
+			env.Mux.HandleFunc("/messages/inbound/MessageID/bypass", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PUT"))
+				env.assertServerHeaders(r)
+				fmt.Fprintf(w, `{ "ErrorCode": 0, "Message": "Successfully bypassed message: MessageID" }`)
+			})
+
+			_, err := env.Client.Messages.BypassInboundRules(context.Background(), "MessageID")
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Retrying a failed inbound message", func() {
+		It("should put to the retry endpoint", func() {
+			env.Mux.HandleFunc("/messages/inbound/MessageID/retry", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PUT"))
+				fmt.Fprintf(w, `{ "ErrorCode": 0, "Message": "Successfully rescheduled failed message: MessageID" }`)
+			})
+
+			_, err := env.Client.Messages.RetryInbound(context.Background(), "MessageID")
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Listing opens", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/messages/outbound/opens", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"TotalCount": 1,
+					"Opens": [{
+						"RecordType": "Open",
+						"FirstOpen": true,
+						"Client": { "Name": "Gmail", "Company": "Google", "Family": "Gmail" },
+						"OS": { "Name": "OS X 10.7 Lion", "Company": "Apple Computer, Inc.", "Family": "OS X 10" },
+						"Platform": "WebMail",
+						"UserAgent": "Mozilla/5.0",
+						"ReadSeconds": 16,
+						"Geo": { "CountryISOCode": "RS", "Country": "Serbia", "City": "Novi Sad", "IP": "188.2.95.4" },
+						"MessageID": "MessageID",
+						"Recipient": "john@example.com"
+					}]
+				}`)
+			})
+		})
+
+		It("should encode the filters as query parameters", func() {
+			_, resp, err := env.Client.Messages.ListOpens(context.Background(), &TrackingListOptions{
+				ListOptions: ListOptions{Count: 25},
+				ClientName:  "Gmail",
+				OSFamily:    "OS X 10",
+				Platform:    "webmail",
+				Country:     "Serbia",
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Request.URL.Path).To(Equal("/messages/outbound/opens"))
+			env.assertServerHeaders(resp.Request)
+
+			q := resp.Request.URL.Query()
+			Expect(q.Get("client_name")).To(Equal("Gmail"))
+			Expect(q.Get("os_family")).To(Equal("OS X 10"))
+			Expect(q.Get("platform")).To(Equal("webmail"))
+			Expect(q.Get("country")).To(Equal("Serbia"))
+		})
+
+		It("should return the correct opens", func() {
+			opens, _, _ := env.Client.Messages.ListOpens(context.Background(), &TrackingListOptions{})
+			Expect(opens).To(Equal(&MessageOpenList{
+				TotalCount: 1,
+				Opens: []MessageOpen{{
+					RecordType:  "Open",
+					FirstOpen:   true,
+					Client:      UserAgentInfo{Name: "Gmail", Company: "Google", Family: "Gmail"},
+					OS:          UserAgentInfo{Name: "OS X 10.7 Lion", Company: "Apple Computer, Inc.", Family: "OS X 10"},
+					Platform:    "WebMail",
+					UserAgent:   "Mozilla/5.0",
+					ReadSeconds: 16,
+					Geo:         GeoInfo{CountryISOCode: "RS", Country: "Serbia", City: "Novi Sad", IP: "188.2.95.4"},
+					MessageID:   "MessageID",
+					Recipient:   "john@example.com",
+				}},
+			}))
+		})
+	})
+
+	Describe("Listing opens for a single message", func() {
+		It("should get the message opens endpoint", func() {
+			env.Mux.HandleFunc("/messages/outbound/opens/MessageID", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Query().Get("count")).To(Equal("10"))
+				fmt.Fprintf(w, `{ "TotalCount": 1, "Opens": [{ "MessageID": "MessageID" }] }`)
+			})
+
+			opens, _, err := env.Client.Messages.ListMessageOpens(context.Background(), "MessageID", &ListOptions{Count: 10})
+			Expect(err).To(BeNil())
+			Expect(opens.Opens).To(HaveLen(1))
+		})
+	})
+
+	Describe("Listing clicks", func() {
+		It("should return the correct clicks", func() {
+			env.Mux.HandleFunc("/messages/outbound/clicks", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Query().Get("tag")).To(Equal("Invitation"))
+				fmt.Fprintf(w, `{
+					"TotalCount": 1,
+					"Clicks": [{
+						"RecordType": "Click",
+						"ClickLocation": "HTML",
+						"OriginalLink": "https://example.com",
+						"Geo": { "Country": "Serbia" }
+					}]
+				}`)
+			})
+
+			clicks, _, err := env.Client.Messages.ListClicks(context.Background(), &TrackingListOptions{Tag: "Invitation"})
+			Expect(err).To(BeNil())
+			Expect(clicks).To(Equal(&MessageClickList{
+				TotalCount: 1,
+				Clicks: []MessageClick{{
+					RecordType:    "Click",
+					ClickLocation: "HTML",
+					OriginalLink:  "https://example.com",
+					Geo:           GeoInfo{Country: "Serbia"},
+				}},
+			}))
+		})
+	})
+
+	Describe("Listing clicks for a single message", func() {
+		It("should get the message clicks endpoint", func() {
+			env.Mux.HandleFunc("/messages/outbound/clicks/MessageID", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{ "TotalCount": 0, "Clicks": [] }`)
+			})
+
+			clicks, _, err := env.Client.Messages.ListMessageClicks(context.Background(), "MessageID", &ListOptions{Count: 10})
+			Expect(err).To(BeNil())
+			Expect(clicks.Clicks).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package postmark
+
+import "net/http"
+
+// A Handler sends an HTTP request to the API and returns its response.
+type Handler func(*http.Request) (*http.Response, error)
+
+// A Middleware wraps a Handler to act on the requests sent through it, for
+// instance to add headers, log requests or stub responses in tests. It may
+// return a response without calling next.
+//
+// A middleware that reads the body of a response before returning it must
+// replace the body with one that can still be read.
+type Middleware func(next Handler) Handler
+
+// handler returns the handler sending a request through the middleware of the
+// client, the first middleware being the outermost one.
+func (c *Client) handler() Handler {
+	h := Handler(c.client.Do)
+	for i := len(c.Middleware) - 1; i >= 0; i-- {
+		h = c.Middleware[i](h)
+	}
+
+	return h
+}
@@ -0,0 +1,132 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var _ = Describe("Middleware", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	It("should be able to change the requests", func() {
+		env.Mux.HandleFunc("/server", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Header.Get("X-Request-ID")).To(Equal("1234"))
+			fmt.Fprintf(w, `{ "ID": 1 }`)
+		})
+		env.Client.Middleware = []Middleware{
+			func(next Handler) Handler {
+				return func(r *http.Request) (*http.Response, error) {
+					r.Header.Set("X-Request-ID", "1234")
+					return next(r)
+				}
+			},
+		}
+
+		_, _, err := env.Client.Server.Get(context.Background())
+		Expect(err).To(BeNil())
+	})
+
+	It("should call the middleware in order", func() {
+		env.Mux.HandleFunc("/server", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{ "ID": 1 }`)
+		})
+
+		var calls []string
+		record := func(name string) Middleware {
+			return func(next Handler) Handler {
+				return func(r *http.Request) (*http.Response, error) {
+					calls = append(calls, name+" before")
+					res, err := next(r)
+					calls = append(calls, fmt.Sprintf("%s after %d", name, res.StatusCode))
+					return res, err
+				}
+			}
+		}
+		env.Client.Middleware = []Middleware{record("first"), record("second")}
+
+		env.Client.Server.Get(context.Background())
+		Expect(calls).To(Equal([]string{
+			"first before",
+			"second before",
+			"second after 200",
+			"first after 200",
+		}))
+	})
+
+	It("should be able to stub the responses", func() {
+		env.Mux.HandleFunc("/server", func(w http.ResponseWriter, r *http.Request) {
+			Fail("the request should not reach the server")
+		})
+		env.Client.Middleware = []Middleware{
+			func(next Handler) Handler {
+				return func(r *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(strings.NewReader(`{ "ID": 42 }`)),
+						Request:    r,
+					}, nil
+				}
+			},
+		}
+
+		server, _, err := env.Client.Server.Get(context.Background())
+		Expect(err).To(BeNil())
+		Expect(*server.ID).To(Equal(int64(42)))
+	})
+
+	It("should return the errors of the middleware", func() {
+		stubErr := errors.New("stub error")
+		env.Client.Middleware = []Middleware{
+			func(next Handler) Handler {
+				return func(r *http.Request) (*http.Response, error) {
+					return nil, stubErr
+				}
+			},
+		}
+
+		_, _, err := env.Client.Server.Get(context.Background())
+		Expect(err).To(Equal(stubErr))
+	})
+
+	It("should wrap every attempt of a retried request", func() {
+		env.Mux.HandleFunc("/server", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+		env.Client.RetryPolicy = &RetryPolicy{
+			MaxAttempts: 3,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  time.Millisecond,
+		}
+
+		attempts := 0
+		env.Client.Middleware = []Middleware{
+			func(next Handler) Handler {
+				return func(r *http.Request) (*http.Response, error) {
+					attempts++
+					return next(r)
+				}
+			},
+		}
+
+		env.Client.Server.Get(context.Background())
+		Expect(attempts).To(Equal(3))
+	})
+})
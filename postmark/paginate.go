@@ -0,0 +1,85 @@
+package postmark
+
+import (
+	"context"
+	"errors"
+	"iter"
+)
+
+const (
+	// defaultPageSize is the number of records requested per page when the
+	// ListOptions of a paginated listing leave Count unset.
+	defaultPageSize = 500
+
+	// maxListOffset is the highest sum of count and offset accepted by the
+	// list endpoints of the Postmark API.
+	maxListOffset = 10000
+)
+
+// ErrOffsetLimit is yielded by a paginated listing that has more records than
+// the API can page through. Use the filters of the list options to narrow
+// the listing.
+var ErrOffsetLimit = errors.New("postmark: list offset limit reached")
+
+// A PageFunc lists the page of records selected by opt, and returns them
+// along with the total number of records.
+type PageFunc[T any] func(ctx context.Context, opt ListOptions) (items []T, totalCount int, err error)
+
+// Paginate returns an iterator over all the records of a paginated listing,
+// starting at the offset of opt. Each page is listed by calling page with the
+// Count and Offset of that page. Count defaults to 500 records per page, and
+// a nil opt lists every record from the start.
+//
+// Paginate does not modify opt, and the iterator lists the records again
+// each time it is used.
+//
+// The iteration stops at the first error, which is yielded with the zero
+// value of T. This includes the error of ctx once it is done, and
+// ErrOffsetLimit when the listing goes beyond the offset limit of the API.
+func Paginate[T any](ctx context.Context, opt *ListOptions, page PageFunc[T]) iter.Seq2[T, error] {
+	var start ListOptions
+	if opt != nil {
+		start = *opt
+	}
+
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		o := start
+		if o.Count <= 0 {
+			o.Count = defaultPageSize
+		}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			if o.Offset >= maxListOffset {
+				yield(zero, ErrOffsetLimit)
+				return
+			}
+			if o.Offset+o.Count > maxListOffset {
+				o.Count = maxListOffset - o.Offset
+			}
+
+			items, totalCount, err := page(ctx, o)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			o.Offset += len(items)
+			if len(items) == 0 || o.Offset >= totalCount {
+				return
+			}
+		}
+	}
+}
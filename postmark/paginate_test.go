@@ -0,0 +1,199 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"encoding/json"
+	"errors"
+	"iter"
+	"net/http"
+	"strconv"
+)
+
+var _ = Describe("Paginate", func() {
+	// pages returns a page function over total records, numbered from 0,
+	// which records the options of every call.
+	pages := func(total int, calls *[]ListOptions) PageFunc[int] {
+		return func(ctx context.Context, opt ListOptions) ([]int, int, error) {
+			*calls = append(*calls, opt)
+
+			var items []int
+			for i := opt.Offset; i < opt.Offset+opt.Count && i < total; i++ {
+				items = append(items, i)
+			}
+			return items, total, nil
+		}
+	}
+
+	collect := func(seq iter.Seq2[int, error]) ([]int, error) {
+		var items []int
+		for item, err := range seq {
+			if err != nil {
+				return items, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	}
+
+	It("should walk every page", func() {
+		var calls []ListOptions
+		opt := &ListOptions{Count: 2}
+
+		items, err := collect(Paginate(context.Background(), opt, pages(5, &calls)))
+		Expect(err).To(BeNil())
+		Expect(items).To(Equal([]int{0, 1, 2, 3, 4}))
+		Expect(calls).To(Equal([]ListOptions{
+			{Count: 2, Offset: 0},
+			{Count: 2, Offset: 2},
+			{Count: 2, Offset: 4},
+		}))
+	})
+
+	It("should not modify the options", func() {
+		var calls []ListOptions
+		opt := &ListOptions{Count: 2, Offset: 1}
+
+		collect(Paginate(context.Background(), opt, pages(5, &calls)))
+		Expect(calls).To(HaveLen(2))
+		Expect(*opt).To(Equal(ListOptions{Count: 2, Offset: 1}))
+	})
+
+	It("should list every record without options", func() {
+		var calls []ListOptions
+
+		items, err := collect(Paginate(context.Background(), nil, pages(600, &calls)))
+		Expect(err).To(BeNil())
+		Expect(items).To(HaveLen(600))
+		Expect(calls[0]).To(Equal(ListOptions{Count: 500, Offset: 0}))
+	})
+
+	It("should list the records again when iterated again", func() {
+		var calls []ListOptions
+		seq := Paginate(context.Background(), &ListOptions{Count: 2}, pages(3, &calls))
+
+		first, _ := collect(seq)
+		second, _ := collect(seq)
+		Expect(first).To(Equal([]int{0, 1, 2}))
+		Expect(second).To(Equal(first))
+		Expect(calls).To(HaveLen(4))
+	})
+
+	It("should request 500 records per page by default", func() {
+		var calls []ListOptions
+		opt := &ListOptions{}
+
+		items, _ := collect(Paginate(context.Background(), opt, pages(600, &calls)))
+		Expect(items).To(HaveLen(600))
+		Expect(calls).To(HaveLen(2))
+		Expect(calls[0].Count).To(Equal(500))
+	})
+
+	It("should stop listing when the loop breaks", func() {
+		var calls []ListOptions
+		opt := &ListOptions{Count: 2}
+
+		for item := range Paginate(context.Background(), opt, pages(5, &calls)) {
+			if item == 1 {
+				break
+			}
+		}
+		Expect(calls).To(HaveLen(1))
+	})
+
+	It("should stop at the first error", func() {
+		pageErr := errors.New("page error")
+		calls := 0
+
+		items, err := collect(Paginate(context.Background(), &ListOptions{}, func(ctx context.Context, opt ListOptions) ([]int, int, error) {
+			calls++
+			return nil, 0, pageErr
+		}))
+		Expect(err).To(Equal(pageErr))
+		Expect(items).To(BeEmpty())
+		Expect(calls).To(Equal(1))
+	})
+
+	It("should stop when the context is done", func() {
+		var calls []ListOptions
+		opt := &ListOptions{Count: 2}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var items []int
+		var err error
+		for item, e := range Paginate(ctx, opt, pages(5, &calls)) {
+			if e != nil {
+				err = e
+				break
+			}
+			items = append(items, item)
+			cancel()
+		}
+		Expect(err).To(Equal(context.Canceled))
+		Expect(items).To(Equal([]int{0, 1}))
+		Expect(calls).To(HaveLen(1))
+	})
+
+	It("should not page beyond the offset limit of the API", func() {
+		var calls []ListOptions
+		opt := &ListOptions{Count: 500, Offset: 9800}
+
+		items, err := collect(Paginate(context.Background(), opt, pages(20000, &calls)))
+		Expect(err).To(Equal(ErrOffsetLimit))
+		Expect(items).To(HaveLen(200))
+		Expect(calls).To(Equal([]ListOptions{{Count: 200, Offset: 9800}}))
+	})
+
+	Describe("Listing all the records of a service", func() {
+		var env *testEnv
+
+		BeforeEach(func() {
+			env = newTestEnv()
+			env.Mux.HandleFunc("/triggers/inboundrules", func(w http.ResponseWriter, r *http.Request) {
+				env.assertServerHeaders(r)
+				count, _ := strconv.Atoi(r.URL.Query().Get("count"))
+				offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+				list := InboundRuleList{TotalCount: 3}
+				for i := offset; i < offset+count && i < 3; i++ {
+					list.InboundRules = append(list.InboundRules, InboundRule{ID: int64(i)})
+				}
+				json.NewEncoder(w).Encode(list)
+			})
+		})
+
+		AfterEach(func() {
+			env.StopServer()
+		})
+
+		It("should return the records of every page", func() {
+			opt := &InboundRuleListOptions{ListOptions: ListOptions{Count: 2}}
+
+			var ids []int64
+			for rule, err := range env.Client.InboundRules.ListAll(context.Background(), opt) {
+				Expect(err).To(BeNil())
+				ids = append(ids, rule.ID)
+			}
+			Expect(ids).To(Equal([]int64{0, 1, 2}))
+			Expect(opt.ListOptions).To(Equal(ListOptions{Count: 2}))
+		})
+
+		It("should return every record each time it is iterated", func() {
+			seq := env.Client.InboundRules.ListAll(context.Background(), &InboundRuleListOptions{ListOptions: ListOptions{Count: 2}})
+
+			for i := 0; i < 2; i++ {
+				var ids []int64
+				for rule, err := range seq {
+					Expect(err).To(BeNil())
+					ids = append(ids, rule.ID)
+				}
+				Expect(ids).To(Equal([]int64{0, 1, 2}))
+			}
+		})
+	})
+})
@@ -7,7 +7,9 @@ package postmark
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -31,6 +33,10 @@ const (
 	contentType = "application/json"
 
 	timeFormat = time.RFC3339
+
+	// defaultPollInterval is the interval used by the polling helpers when
+	// they are given an interval of zero or less.
+	defaultPollInterval = time.Second
 )
 
 // A Client manages communication with the Postmark API.
@@ -45,8 +51,33 @@ type Client struct {
 	ServerToken  string
 	AccountToken string
 
+	// RetryPolicy configures the retries of requests that failed with a
+	// transient error. Requests are not retried when it is nil.
+	RetryPolicy *RetryPolicy
+
+	// RateLimiter limits the rate and the concurrency of the requests sent
+	// by the client. Requests are not limited when it is nil.
+	RateLimiter *RateLimiter
+
+	// Middleware wraps every attempt at sending a request made by Do, after
+	// the rate limiter, in the order of the slice.
+	Middleware []Middleware
+
 	// Services used for talking to different parts of the Postmark API.
-	Email *EmailService
+	Email            *EmailService
+	Bounces          *BounceService
+	Templates        *TemplateService
+	Messages         *MessageService
+	Stats            *StatsService
+	Server           *CurrentServerService
+	Servers          *ServerService
+	MessageStreams   *MessageStreamService
+	Suppressions     *SuppressionService
+	Webhooks         *WebhookService
+	Domains          *DomainService
+	SenderSignatures *SenderSignatureService
+	InboundRules     *InboundRuleService
+	DataRemovals     *DataRemovalService
 }
 
 // NewClient returns a new Postmark API client. If httpClient is nil,
@@ -64,10 +95,34 @@ func NewClient(httpClient *http.Client) *Client {
 
 	// configure services
 	c.Email = &EmailService{client: c}
+	c.Bounces = &BounceService{client: c}
+	c.Templates = &TemplateService{client: c}
+	c.Messages = &MessageService{client: c}
+	c.Stats = &StatsService{client: c}
+	c.Server = &CurrentServerService{client: c}
+	c.Servers = &ServerService{client: c}
+	c.MessageStreams = &MessageStreamService{client: c}
+	c.Suppressions = &SuppressionService{client: c}
+	c.Webhooks = &WebhookService{client: c}
+	c.Domains = &DomainService{client: c}
+	c.SenderSignatures = &SenderSignatureService{client: c}
+	c.InboundRules = &InboundRuleService{client: c}
+	c.DataRemovals = &DataRemovalService{client: c}
 
 	return c
 }
 
+// ListOptions specifies the optional parameters to various List methods that
+// support pagination. Count is required by the Postmark API for most list
+// endpoints.
+type ListOptions struct {
+	// Count is the number of records to return per request.
+	Count int `url:"count"`
+
+	// Offset is the number of records to skip.
+	Offset int `url:"offset"`
+}
+
 // addOptions adds the parameters in opt as URL query parameters to s.
 // opt must be a struct whose fields may contain "url" tags.
 //
@@ -93,12 +148,19 @@ func addOptions(s string, opt interface{}) (string, error) {
 	return u.String(), nil
 }
 
+// errNonNilContext is returned by NewRequest when it is given a nil context.
+var errNonNilContext = errors.New("postmark: context must be non-nil")
+
 // NewRequest creates an API request. A relative URL can be provided in path,
 // in which case it is resolved relative to the BaseURL of the client.
 // Relative URLs should always be specified without the preceding slash. If
 // specified, the value pointed to by body is JSON encoded and included as the
-// request body
-func (c *Client) NewRequest(method, path string, body interface{}) (*http.Request, error) {
+// request body. The request is bound to ctx, which cancels it when done.
+func (c *Client) NewRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	if ctx == nil {
+		return nil, errNonNilContext
+	}
+
 	rel, err := url.Parse(path)
 	if err != nil {
 		return nil, err
@@ -120,15 +182,58 @@ func (c *Client) NewRequest(method, path string, body interface{}) (*http.Reques
 		return nil, err
 	}
 
+	return req.WithContext(ctx), nil
+}
+
+// newServerRequest creates an API request like NewRequest and sets the
+// headers required by endpoints authenticated with the server token.
+func (c *Client) newServerRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	req, err := c.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set(headerContentType, contentType)
+	req.Header.Set(headerAccept, acceptType)
+	req.Header.Set(headerServerToken, c.ServerToken)
+
+	return req, nil
+}
+
+// newAccountRequest creates an API request like NewRequest and sets the
+// headers required by endpoints authenticated with the account token.
+func (c *Client) newAccountRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	req, err := c.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set(headerContentType, contentType)
+	req.Header.Set(headerAccept, acceptType)
+	req.Header.Set(headerAccountToken, c.AccountToken)
+
 	return req, nil
 }
 
 // Do sends an API request and returns the API response. The API response is
 // JSON decoded and stored in the value pointed to by v, or returned as an
 // error if an API error has occurred.
+//
+// The request is retried according to the RetryPolicy of the client, and
+// every attempt waits for the RateLimiter of the client and then goes through
+// its Middleware. It is canceled when its context is done, in which case the
+// error is the context's error, context.Canceled or context.DeadlineExceeded.
 func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
-	res, err := c.client.Do(req)
+	res, err := c.send(req)
 	if err != nil {
+		// If the context is done, its error is more useful than the one
+		// returned by the HTTP client.
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		default:
+		}
+
 		return nil, err
 	}
 
@@ -146,7 +251,32 @@ func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 	return res, err
 }
 
-// An ErrorResponse reports an error caused by an API request.
+// roundTrip makes a single attempt at sending req through the middleware of
+// the client, once its rate limiter allows it. The request holds its slot of
+// the limiter until the body of its response is closed.
+func (c *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	if c.RateLimiter == nil {
+		return c.handler()(req)
+	}
+
+	release, err := c.RateLimiter.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.handler()(req)
+	if err != nil || res == nil || res.Body == nil {
+		release()
+		return res, err
+	}
+
+	res.Body = &releaseBody{ReadCloser: res.Body, release: release}
+	return res, nil
+}
+
+// An ErrorResponse reports an error caused by an API request. The ErrorCode
+// constants list the known error codes, and the corresponding sentinel errors
+// can be matched with errors.Is.
 type ErrorResponse struct {
 	Response  *http.Response
 	ErrorCode int    `json:"ErrorCode"`
@@ -159,7 +289,9 @@ func (e *ErrorResponse) Error() string {
 
 // CheckResponse checks the API response for errors, and returns them if
 // present. A response is considered an error if it has a status code outside
-// the 200 range.
+// the 200 range. Inactive recipient errors are returned as an
+// *InactiveRecipientError, and other errors as an *ErrorResponse. Use
+// errors.As to get the *ErrorResponse of either.
 func CheckResponse(r *http.Response) error {
 	c := r.StatusCode
 	if 200 <= c && c <= 299 {
@@ -172,6 +304,10 @@ func CheckResponse(r *http.Response) error {
 		json.Unmarshal(data, er)
 	}
 
+	if er.ErrorCode == ErrorCodeInactiveRecipient {
+		return newInactiveRecipientError(er)
+	}
+
 	return er
 }
 
@@ -194,6 +330,14 @@ func Int(v int) *int {
 	return p
 }
 
+// Int64 is a helper function that allocates a new int64 value to store v and
+// returns a pointer to it.
+func Int64(v int64) *int64 {
+	p := new(int64)
+	*p = v
+	return p
+}
+
 // String is a helper function that allocates a new string value to store v and
 // returns a pointer to it.
 func String(v string) *string {
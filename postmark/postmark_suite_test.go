@@ -1,7 +1,7 @@
 package postmark_test
 
 import (
-	. "github.com/hudl/go-postmark/postmark"
+	. "github.com/hudl/go-postmark/v2/postmark"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -42,6 +42,8 @@ func newTestEnv() *testEnv {
 	client := NewClient(nil)
 	url, _ := url.Parse(server.URL)
 	client.BaseURL = url
+	client.ServerToken = "server-token"
+	client.AccountToken = "account-token"
 
 	return &testEnv{
 		Mux:    mux,
@@ -50,6 +52,23 @@ func newTestEnv() *testEnv {
 	}
 }
 
+// assertServerHeaders checks that r carries the headers used by endpoints
+// authenticated with the server token.
+func (env *testEnv) assertServerHeaders(r *http.Request) {
+	Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+	Expect(r.Header.Get("Accept")).To(Equal("application/json"))
+	Expect(r.Header.Get("X-Postmark-Server-Token")).To(Equal(env.Client.ServerToken))
+}
+
+// assertAccountHeaders checks that r carries the headers used by endpoints
+// authenticated with the account token.
+func (env *testEnv) assertAccountHeaders(r *http.Request) {
+	Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+	Expect(r.Header.Get("Accept")).To(Equal("application/json"))
+	Expect(r.Header.Get("X-Postmark-Account-Token")).To(Equal(env.Client.AccountToken))
+	Expect(r.Header.Get("X-Postmark-Server-Token")).To(BeEmpty())
+}
+
 // StopServer closes the test environment's HTTP server.
 func (env *testEnv) StopServer() {
 	env.Server.Close()
@@ -1,17 +1,19 @@
 package postmark_test
 
 import (
-	. "github.com/hudl/go-postmark/postmark"
+	. "github.com/hudl/go-postmark/v2/postmark"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 var _ = Describe("Postmark", func() {
@@ -34,6 +36,19 @@ var _ = Describe("Postmark", func() {
 
 			It("should register all services correctly", func() {
 				Expect(client.Email).NotTo(BeNil())
+				Expect(client.Bounces).NotTo(BeNil())
+				Expect(client.Templates).NotTo(BeNil())
+				Expect(client.Messages).NotTo(BeNil())
+				Expect(client.Stats).NotTo(BeNil())
+				Expect(client.Server).NotTo(BeNil())
+				Expect(client.Servers).NotTo(BeNil())
+				Expect(client.MessageStreams).NotTo(BeNil())
+				Expect(client.Suppressions).NotTo(BeNil())
+				Expect(client.Webhooks).NotTo(BeNil())
+				Expect(client.Domains).NotTo(BeNil())
+				Expect(client.SenderSignatures).NotTo(BeNil())
+				Expect(client.InboundRules).NotTo(BeNil())
+				Expect(client.DataRemovals).NotTo(BeNil())
 			})
 		})
 	})
@@ -48,7 +63,7 @@ var _ = Describe("Postmark", func() {
 			type T struct{ A int }
 
 			BeforeEach(func() {
-				req, err = client.NewRequest("GET", "/test", &T{A: 0})
+				req, err = client.NewRequest(context.Background(), "GET", "/test", &T{A: 0})
 			})
 
 			It("should not return an error", func() {
@@ -74,10 +89,10 @@ var _ = Describe("Postmark", func() {
 
 		Context("with invalid JSON", func() {
 			// test type with an unsupported json type
-			type T struct{ A map[int]interface{} }
+			type T struct{ A chan int }
 
 			It("should return a JSON unsupported type error", func() {
-				_, err := client.NewRequest("GET", "/", &T{})
+				_, err := client.NewRequest(context.Background(), "GET", "/", &T{})
 				Expect(err).NotTo(BeNil())
 
 				_, ok := err.(*json.UnsupportedTypeError)
@@ -87,7 +102,7 @@ var _ = Describe("Postmark", func() {
 
 		Context("with an invalid realtive path", func() {
 			It("should return a URL parse error", func() {
-				_, err := client.NewRequest("GET", ":", nil)
+				_, err := client.NewRequest(context.Background(), "GET", ":", nil)
 				Expect(err).NotTo(BeNil())
 
 				urlErr, ok := err.(*url.Error)
@@ -96,9 +111,27 @@ var _ = Describe("Postmark", func() {
 			})
 		})
 
+		Context("with a nil context", func() {
+			It("should return an error", func() {
+				_, err := client.NewRequest(nil, "GET", "/", nil)
+				Expect(err).To(MatchError("postmark: context must be non-nil"))
+			})
+		})
+
+		Context("with a context", func() {
+			It("should bind the request to the context", func() {
+				type key struct{}
+				ctx := context.WithValue(context.Background(), key{}, "value")
+
+				req, err := client.NewRequest(ctx, "GET", "/", nil)
+				Expect(err).To(BeNil())
+				Expect(req.Context().Value(key{})).To(Equal("value"))
+			})
+		})
+
 		Context("with an empty (nil) body", func() {
 			BeforeEach(func() {
-				req, err = client.NewRequest("GET", "/", nil)
+				req, err = client.NewRequest(context.Background(), "GET", "/", nil)
 			})
 
 			It("should not return an error", func() {
@@ -130,7 +163,7 @@ var _ = Describe("Postmark", func() {
 					fmt.Fprintf(w, `{ "A": 0 }`)
 				})
 
-				req, _ := env.Client.NewRequest("GET", "/", nil)
+				req, _ := env.Client.NewRequest(context.Background(), "GET", "/", nil)
 				body := new(T)
 				env.Client.Do(req, body)
 
@@ -144,19 +177,48 @@ var _ = Describe("Postmark", func() {
 					http.Error(w, "Bad Request", http.StatusBadRequest)
 				})
 
-				req, _ := env.Client.NewRequest("GET", "/", nil)
+				req, _ := env.Client.NewRequest(context.Background(), "GET", "/", nil)
 				_, err := env.Client.Do(req, nil)
 				Expect(err).NotTo(BeNil())
 			})
 		})
 
+		Context("when the context is canceled", func() {
+			It("should return context.Canceled", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				env.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+					cancel()
+					<-r.Context().Done()
+				})
+
+				req, _ := env.Client.NewRequest(ctx, "GET", "/", nil)
+				resp, err := env.Client.Do(req, nil)
+				Expect(err).To(Equal(context.Canceled))
+				Expect(resp).To(BeNil())
+			})
+		})
+
+		Context("when the context deadline is exceeded", func() {
+			It("should return context.DeadlineExceeded", func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+				defer cancel()
+				env.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+					<-r.Context().Done()
+				})
+
+				req, _ := env.Client.NewRequest(ctx, "GET", "/", nil)
+				_, err := env.Client.Do(req, nil)
+				Expect(err).To(Equal(context.DeadlineExceeded))
+			})
+		})
+
 		Context("when the native http client produces an error from a redirect loop", func() {
 			It("should return a URL error", func() {
 				env.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 					http.Redirect(w, r, "/", http.StatusFound)
 				})
 
-				req, _ := env.Client.NewRequest("GET", "/", nil)
+				req, _ := env.Client.NewRequest(context.Background(), "GET", "/", nil)
 				_, err := env.Client.Do(req, nil)
 				Expect(err).NotTo(BeNil())
 
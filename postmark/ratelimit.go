@@ -0,0 +1,178 @@
+package postmark
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the rate and the concurrency of the requests sent by a
+// Client. The rate is enforced with a token bucket, which allows bursts of
+// requests up to its size. A RateLimiter can be shared by several clients to
+// apply a common limit.
+type RateLimiter struct {
+	rate  float64
+	burst float64
+
+	// slots holds a value for each request in flight. It is nil when the
+	// concurrency is not limited.
+	slots chan struct{}
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+	stats  RateLimiterStats
+}
+
+// RateLimiterStats reports the activity of a RateLimiter.
+type RateLimiterStats struct {
+	// Requests is the number of requests that went through the limiter.
+	Requests int64
+
+	// Waits is the number of requests that were blocked by the limiter, and
+	// WaitTime is the total time they were blocked for.
+	Waits    int64
+	WaitTime time.Duration
+
+	// InFlight is the number of requests currently in flight.
+	InFlight int
+}
+
+// NewRateLimiter returns a limiter that allows rate requests per second, with
+// bursts of up to burst requests, and at most maxInFlight concurrent requests.
+// The rate or the concurrency is not limited when rate or maxInFlight is zero.
+func NewRateLimiter(rate float64, burst, maxInFlight int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	l := &RateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+	if maxInFlight > 0 {
+		l.slots = make(chan struct{}, maxInFlight)
+	}
+
+	return l
+}
+
+// Stats returns the activity of the limiter so far.
+func (l *RateLimiter) Stats() RateLimiterStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	stats := l.stats
+	stats.InFlight = len(l.slots)
+	return stats
+}
+
+// acquire blocks until a request can be sent within the limits, or ctx is
+// done. The returned function must be called once the request is complete,
+// including the transfer of the response body.
+func (l *RateLimiter) acquire(ctx context.Context) (func(), error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	blocked := false
+	defer func() {
+		l.record(blocked, time.Since(start))
+	}()
+
+	if d := l.reserve(); d > 0 {
+		blocked = true
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			l.unreserve()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if l.slots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+	default:
+		blocked = true
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return func() { <-l.slots }, nil
+}
+
+// reserve takes a token from the bucket and returns how long to wait for it
+// to be available.
+func (l *RateLimiter) reserve() time.Duration {
+	if l.rate <= 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// unreserve puts back a token taken by a request that was canceled while
+// waiting for it.
+func (l *RateLimiter) unreserve() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// record adds a request to the stats of the limiter.
+func (l *RateLimiter) record(blocked bool, d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.stats.Requests++
+	if blocked {
+		l.stats.Waits++
+		l.stats.WaitTime += d
+	}
+}
+
+// releaseBody holds the in-flight slot of a request until the body of its
+// response is closed, so the limit also covers the transfer of the body.
+type releaseBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
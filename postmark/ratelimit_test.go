@@ -0,0 +1,151 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var _ = Describe("RateLimiter", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	get := func(ctx context.Context) error {
+		_, _, err := env.Client.Server.Get(ctx)
+		return err
+	}
+
+	Context("with a rate limit", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/server", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{ "ID": 1 }`)
+			})
+		})
+
+		It("should space out the requests beyond the burst", func() {
+			env.Client.RateLimiter = NewRateLimiter(50, 1, 0)
+
+			start := time.Now()
+			for i := 0; i < 3; i++ {
+				Expect(get(context.Background())).To(Succeed())
+			}
+			Expect(time.Since(start)).To(BeNumerically(">=", 30*time.Millisecond))
+
+			stats := env.Client.RateLimiter.Stats()
+			Expect(stats.Requests).To(Equal(int64(3)))
+			Expect(stats.Waits).To(Equal(int64(2)))
+			Expect(stats.WaitTime).To(BeNumerically(">=", 30*time.Millisecond))
+		})
+
+		It("should not wait within the burst", func() {
+			env.Client.RateLimiter = NewRateLimiter(1, 3, 0)
+
+			for i := 0; i < 3; i++ {
+				Expect(get(context.Background())).To(Succeed())
+			}
+			Expect(env.Client.RateLimiter.Stats().Waits).To(BeZero())
+		})
+
+		It("should stop waiting when the context is done", func() {
+			env.Client.RateLimiter = NewRateLimiter(0.1, 1, 0)
+			Expect(get(context.Background())).To(Succeed())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			start := time.Now()
+			Expect(get(ctx)).To(Equal(context.DeadlineExceeded))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+	})
+
+	Context("with a concurrency limit", func() {
+		It("should cap the number of requests in flight", func() {
+			var inFlight, maxInFlight int32
+			env.Mux.HandleFunc("/server", func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					m := atomic.LoadInt32(&maxInFlight)
+					if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+						break
+					}
+				}
+
+				time.Sleep(10 * time.Millisecond)
+				fmt.Fprintf(w, `{ "ID": 1 }`)
+			})
+			env.Client.RateLimiter = NewRateLimiter(0, 0, 2)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 6; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					Expect(get(context.Background())).To(Succeed())
+				}()
+			}
+			wg.Wait()
+
+			Expect(atomic.LoadInt32(&maxInFlight)).To(Equal(int32(2)))
+
+			stats := env.Client.RateLimiter.Stats()
+			Expect(stats.Requests).To(Equal(int64(6)))
+			Expect(stats.Waits).To(BeNumerically(">", 0))
+			Expect(stats.InFlight).To(BeZero())
+		})
+
+		It("should hold the slot of a request until its response body is read", func() {
+			body, writer := io.Pipe()
+			returned := make(chan struct{})
+			env.Client.Middleware = []Middleware{
+				func(next Handler) Handler {
+					return func(r *http.Request) (*http.Response, error) {
+						defer close(returned)
+						return next(r)
+					}
+				},
+				func(next Handler) Handler {
+					return func(r *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       body,
+							Request:    r,
+						}, nil
+					}
+				},
+			}
+			env.Client.RateLimiter = NewRateLimiter(0, 0, 1)
+
+			done := make(chan error)
+			go func() {
+				done <- get(context.Background())
+			}()
+
+			<-returned
+			Expect(env.Client.RateLimiter.Stats().InFlight).To(Equal(1))
+
+			fmt.Fprintf(writer, `{ "ID": 1 }`)
+			writer.Close()
+			Expect(<-done).To(Succeed())
+			Expect(env.Client.RateLimiter.Stats().InFlight).To(BeZero())
+		})
+	})
+})
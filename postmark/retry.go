@@ -0,0 +1,273 @@
+package postmark
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 10 * time.Second
+)
+
+// DefaultRetryableStatusCodes are the status codes retried when a RetryPolicy
+// does not list any. Postmark only returns them for requests it did not
+// process, so retrying them is safe for every method, including sends.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusServiceUnavailable,
+}
+
+// RetryPolicy configures how the client retries requests that failed with a
+// transient error. A request is only retried when it is safe to do so:
+//
+//   - the response has one of the RetryableStatusCodes,
+//   - the connection to the API could not be established, or
+//   - the method is idempotent and the request failed with a network error
+//     before a response was received.
+//
+// Errors returned by the Middleware of the client are not retried, and
+// requests are never retried once their context is done.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first
+	// one. Values below 2 disable retries.
+	MaxAttempts int
+
+	// MinBackoff and MaxBackoff bound the delay between attempts. The delay
+	// starts at MinBackoff and doubles after each attempt, up to MaxBackoff,
+	// and a random jitter of up to half the delay is subtracted from it.
+	// They default to 500ms and 10s. A response whose Retry-After header
+	// requests a longer delay than MaxBackoff is not retried.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// RetryableStatusCodes are the HTTP status codes that are retried. If
+	// nil, DefaultRetryableStatusCodes is used. Codes such as 500 or 504 may
+	// be returned for requests that were processed, and retrying them can
+	// send an email twice.
+	RetryableStatusCodes []int
+
+	// OnAttempt, if set, is called after every attempt.
+	OnAttempt func(RetryAttempt)
+}
+
+// RetryAttempt describes an attempt at sending a request.
+type RetryAttempt struct {
+	Request *http.Request
+
+	// Attempt is the number of the attempt, starting at 1.
+	Attempt int
+
+	// Response is the response to the attempt, or nil if it failed with Err.
+	// Its body must not be read.
+	Response *http.Response
+	Err      error
+
+	// Retry reports whether the request is retried, after waiting for Delay.
+	// The delay is the one requested by the Retry-After header of the
+	// response, if any.
+	Retry bool
+	Delay time.Duration
+}
+
+// DefaultRetryPolicy returns a policy that makes up to three attempts with
+// the default backoff and status codes.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  defaultMinBackoff,
+		MaxBackoff:  defaultMaxBackoff,
+	}
+}
+
+// send sends req, retrying it according to the retry policy of the client.
+// The response of the last attempt is returned, whatever its status code.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	p := c.RetryPolicy
+	if p == nil || p.MaxAttempts < 2 {
+		return c.roundTrip(req)
+	}
+
+	// Buffer the body so it can be sent again on each attempt.
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		r := req.WithContext(ctx)
+		if body != nil {
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		res, err := c.roundTrip(r)
+
+		var retry bool
+		if err != nil {
+			retry = retryableError(r, err)
+		} else {
+			retry = p.retryableStatus(res.StatusCode)
+		}
+		retry = retry && attempt < p.MaxAttempts && ctx.Err() == nil
+
+		var delay time.Duration
+		if retry {
+			delay = p.backoff(attempt)
+			if d, ok := retryAfter(res); ok {
+				delay = d
+			}
+
+			// Give up rather than block for longer than the policy allows.
+			if delay > p.maxBackoff() {
+				retry, delay = false, 0
+			}
+		}
+
+		if p.OnAttempt != nil {
+			p.OnAttempt(RetryAttempt{
+				Request:  r,
+				Attempt:  attempt,
+				Response: res,
+				Err:      err,
+				Retry:    retry,
+				Delay:    delay,
+			})
+		}
+
+		if !retry {
+			return res, err
+		}
+
+		if res != nil {
+			// Drain the body so the connection can be reused.
+			io.Copy(ioutil.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryableStatus reports whether responses with the status code are retried.
+func (p *RetryPolicy) retryableStatus(code int) bool {
+	codes := p.RetryableStatusCodes
+	if codes == nil {
+		codes = DefaultRetryableStatusCodes
+	}
+
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// minBackoff returns the MinBackoff of the policy, or its default.
+func (p *RetryPolicy) minBackoff() time.Duration {
+	if p.MinBackoff <= 0 {
+		return defaultMinBackoff
+	}
+	return p.MinBackoff
+}
+
+// maxBackoff returns the MaxBackoff of the policy, or its default. It is never
+// less than the minimum backoff.
+func (p *RetryPolicy) maxBackoff() time.Duration {
+	max := p.MaxBackoff
+	if max <= 0 {
+		max = defaultMaxBackoff
+	}
+	if min := p.minBackoff(); max < min {
+		max = min
+	}
+	return max
+}
+
+// backoff returns the delay to wait for after the given attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	max := p.maxBackoff()
+
+	d := p.minBackoff()
+	for i := 1; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+
+	return d - time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// retryableError reports whether a request that failed with err before a
+// response was received is safe to retry. Only the network errors returned by
+// the HTTP client are retried.
+func retryableError(req *http.Request, err error) bool {
+	var ue *url.Error
+	if !errors.As(err, &ue) {
+		return false
+	}
+
+	var ne net.Error
+	if !errors.As(ue.Err, &ne) && ue.Err != io.EOF && ue.Err != io.ErrUnexpectedEOF {
+		return false
+	}
+
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+
+	// Other requests may have been processed, unless the connection could
+	// not even be established.
+	var oe *net.OpError
+	return errors.As(ue.Err, &oe) && oe.Op == "dial"
+}
+
+// retryAfter returns the delay requested by the Retry-After header of res,
+// given either in seconds or as an HTTP date.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	v := res.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := t.Sub(time.Now())
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,214 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+var _ = Describe("Retry", func() {
+	var (
+		env      *testEnv
+		calls    int32
+		attempts []RetryAttempt
+	)
+
+	BeforeEach(func() {
+		env = newTestEnv()
+		atomic.StoreInt32(&calls, 0)
+		attempts = nil
+		env.Client.RetryPolicy = &RetryPolicy{
+			MaxAttempts: 3,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  time.Millisecond,
+			OnAttempt: func(a RetryAttempt) {
+				attempts = append(attempts, a)
+			},
+		}
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	// failTimes responds with the status code to the first n requests, and
+	// then succeeds.
+	failTimes := func(n int32, code int) {
+		env.Mux.HandleFunc("/email", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			Expect(body).To(MatchJSON(`{ "To": "receiver@example.com" }`))
+
+			if atomic.AddInt32(&calls, 1) <= n {
+				w.WriteHeader(code)
+				fmt.Fprintf(w, `{ "ErrorCode": 0, "Message": "Unavailable" }`)
+				return
+			}
+			fmt.Fprintf(w, `{ "MessageID": "0a129aee-e1cd-480d-b08d-4f48548ff48d" }`)
+		})
+	}
+
+	send := func() (*EmailResult, error) {
+		result, _, err := env.Client.Email.Send(context.Background(), &Email{
+			To: String("receiver@example.com"),
+		})
+		return result, err
+	}
+
+	Context("without a retry policy", func() {
+		It("should make a single attempt", func() {
+			env.Client.RetryPolicy = nil
+			failTimes(1, http.StatusServiceUnavailable)
+
+			_, err := send()
+			Expect(err).To(BeAssignableToTypeOf(&ErrorResponse{}))
+			Expect(atomic.LoadInt32(&calls)).To(Equal(int32(1)))
+		})
+	})
+
+	Context("when the response has a retryable status code", func() {
+		It("should resend the request until it succeeds", func() {
+			failTimes(2, http.StatusTooManyRequests)
+
+			result, err := send()
+			Expect(err).To(BeNil())
+			Expect(result.MessageID).To(Equal("0a129aee-e1cd-480d-b08d-4f48548ff48d"))
+			Expect(atomic.LoadInt32(&calls)).To(Equal(int32(3)))
+		})
+
+		It("should report every attempt", func() {
+			failTimes(1, http.StatusServiceUnavailable)
+
+			send()
+			Expect(attempts).To(HaveLen(2))
+			Expect(attempts[0].Attempt).To(Equal(1))
+			Expect(attempts[0].Response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+			Expect(attempts[0].Retry).To(BeTrue())
+			Expect(attempts[0].Delay).To(BeNumerically("<=", time.Millisecond))
+			Expect(attempts[1].Attempt).To(Equal(2))
+			Expect(attempts[1].Response.StatusCode).To(Equal(http.StatusOK))
+			Expect(attempts[1].Retry).To(BeFalse())
+		})
+
+		It("should give up after the maximum number of attempts", func() {
+			failTimes(5, http.StatusServiceUnavailable)
+
+			_, err := send()
+			Expect(err).To(BeAssignableToTypeOf(&ErrorResponse{}))
+			Expect(err.(*ErrorResponse).Message).To(Equal("Unavailable"))
+			Expect(atomic.LoadInt32(&calls)).To(Equal(int32(3)))
+		})
+	})
+
+	Context("when the response has another status code", func() {
+		It("should not retry it by default", func() {
+			failTimes(1, http.StatusInternalServerError)
+
+			_, err := send()
+			Expect(err).NotTo(BeNil())
+			Expect(atomic.LoadInt32(&calls)).To(Equal(int32(1)))
+		})
+
+		It("should retry it when configured", func() {
+			env.Client.RetryPolicy.RetryableStatusCodes = []int{http.StatusInternalServerError}
+			failTimes(1, http.StatusInternalServerError)
+
+			_, err := send()
+			Expect(err).To(BeNil())
+			Expect(atomic.LoadInt32(&calls)).To(Equal(int32(2)))
+		})
+	})
+
+	Context("when the response has a Retry-After header", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/email", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				w.Header().Set("Retry-After", "30")
+				w.WriteHeader(http.StatusTooManyRequests)
+			})
+		})
+
+		It("should wait for the requested delay", func() {
+			env.Client.RetryPolicy.MaxBackoff = time.Minute
+
+			ctx, cancel := context.WithCancel(context.Background())
+			env.Client.RetryPolicy.OnAttempt = func(a RetryAttempt) {
+				attempts = append(attempts, a)
+				cancel()
+			}
+
+			_, _, err := env.Client.Email.Send(ctx, &Email{})
+			Expect(err).To(Equal(context.Canceled))
+			Expect(attempts).To(HaveLen(1))
+			Expect(attempts[0].Delay).To(Equal(30 * time.Second))
+		})
+
+		It("should not retry when the delay exceeds the maximum backoff", func() {
+			_, _, err := env.Client.Email.Send(context.Background(), &Email{})
+			Expect(errors.Is(err, ErrRateLimited)).To(BeTrue())
+			Expect(atomic.LoadInt32(&calls)).To(Equal(int32(1)))
+			Expect(attempts).To(HaveLen(1))
+			Expect(attempts[0].Retry).To(BeFalse())
+		})
+	})
+
+	Context("when the middleware returns an error", func() {
+		It("should not retry the request", func() {
+			middlewareErr := errors.New("middleware error")
+			env.Client.Middleware = []Middleware{
+				func(next Handler) Handler {
+					return func(r *http.Request) (*http.Response, error) {
+						atomic.AddInt32(&calls, 1)
+						return nil, middlewareErr
+					}
+				},
+			}
+
+			_, _, err := env.Client.Templates.Get(context.Background(), "welcome")
+			Expect(err).To(Equal(middlewareErr))
+			Expect(atomic.LoadInt32(&calls)).To(Equal(int32(1)))
+		})
+	})
+
+	Context("when the connection is closed before a response", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				conn, _, _ := w.(http.Hijacker).Hijack()
+				conn.Close()
+			})
+		})
+
+		It("should retry idempotent requests", func() {
+			_, _, err := env.Client.Templates.Get(context.Background(), "welcome")
+			Expect(err).NotTo(BeNil())
+			Expect(atomic.LoadInt32(&calls)).To(Equal(int32(3)))
+		})
+
+		It("should not retry other requests", func() {
+			_, err := send()
+			Expect(err).NotTo(BeNil())
+			Expect(atomic.LoadInt32(&calls)).To(Equal(int32(1)))
+		})
+	})
+
+	Context("when the connection cannot be established", func() {
+		It("should retry any request", func() {
+			env.StopServer()
+
+			_, err := send()
+			Expect(err).NotTo(BeNil())
+			Expect(attempts).To(HaveLen(3))
+			Expect(attempts[0].Err).NotTo(BeNil())
+			Expect(attempts[0].Retry).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,172 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// SenderSignatureService handles communication with the Sender Signatures
+// related methods of the Postmark API. These methods are authenticated with
+// the account token.
+type SenderSignatureService struct {
+	client *Client
+}
+
+// SenderSignature represents a confirmed or pending From address along with
+// the DNS records of its domain. The same type is used to create and edit
+// sender signatures, in which case nil fields are left unchanged.
+type SenderSignature struct {
+	ID        *int64  `json:"ID,omitempty"`
+	Domain    *string `json:"Domain,omitempty"`
+	Name      *string `json:"Name,omitempty"`
+	Confirmed *bool   `json:"Confirmed,omitempty"`
+
+	// EmailAddress and ReplyToEmailAddress are the addresses returned by the
+	// API. They are set with FromEmail and ReplyToEmail instead, and the
+	// FromEmail can only be set when creating a signature.
+	EmailAddress        *string `json:"EmailAddress,omitempty"`
+	ReplyToEmailAddress *string `json:"ReplyToEmailAddress,omitempty"`
+	FromEmail           *string `json:"FromEmail,omitempty"`
+	ReplyToEmail        *string `json:"ReplyToEmail,omitempty"`
+
+	SPFVerified  *bool   `json:"SPFVerified,omitempty"`
+	SPFHost      *string `json:"SPFHost,omitempty"`
+	SPFTextValue *string `json:"SPFTextValue,omitempty"`
+
+	// The DKIM fields are the same as those of a Domain.
+	DKIMVerified                  *bool   `json:"DKIMVerified,omitempty"`
+	WeakDKIM                      *bool   `json:"WeakDKIM,omitempty"`
+	DKIMHost                      *string `json:"DKIMHost,omitempty"`
+	DKIMTextValue                 *string `json:"DKIMTextValue,omitempty"`
+	DKIMPendingHost               *string `json:"DKIMPendingHost,omitempty"`
+	DKIMPendingTextValue          *string `json:"DKIMPendingTextValue,omitempty"`
+	DKIMRevokedHost               *string `json:"DKIMRevokedHost,omitempty"`
+	DKIMRevokedTextValue          *string `json:"DKIMRevokedTextValue,omitempty"`
+	SafeToRemoveRevokedKeyFromDNS *bool   `json:"SafeToRemoveRevokedKeyFromDNS,omitempty"`
+	DKIMUpdateStatus              *string `json:"DKIMUpdateStatus,omitempty"`
+
+	ReturnPathDomain           *string `json:"ReturnPathDomain,omitempty"`
+	ReturnPathDomainVerified   *bool   `json:"ReturnPathDomainVerified,omitempty"`
+	ReturnPathDomainCNAMEValue *string `json:"ReturnPathDomainCNAMEValue,omitempty"`
+
+	ConfirmationPersonalNote *string `json:"ConfirmationPersonalNote,omitempty"`
+}
+
+// SenderSignatureList is a page of sender signatures returned by
+// SenderSignatureService.List.
+type SenderSignatureList struct {
+	TotalCount       int
+	SenderSignatures []SenderSignature
+}
+
+// SenderSignatureListOptions specifies the optional parameters to the
+// SenderSignatureService.List method.
+type SenderSignatureListOptions struct {
+	ListOptions
+}
+
+// List returns the sender signatures of the account. The listed signatures
+// only contain the address details, not the DNS records.
+func (s *SenderSignatureService) List(ctx context.Context, opt *SenderSignatureListOptions) (*SenderSignatureList, *http.Response, error) {
+	u, err := addOptions("senders", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newAccountRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signatures := new(SenderSignatureList)
+	resp, err := s.client.Do(req, signatures)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return signatures, resp, err
+}
+
+// ListAll returns an iterator over all the sender signatures of the account,
+// with their address details only. See Paginate.
+func (s *SenderSignatureService) ListAll(ctx context.Context, opt *SenderSignatureListOptions) iter.Seq2[SenderSignature, error] {
+	var base SenderSignatureListOptions
+	if opt != nil {
+		base = *opt
+	}
+
+	return Paginate(ctx, &base.ListOptions, func(ctx context.Context, page ListOptions) ([]SenderSignature, int, error) {
+		o := base
+		o.ListOptions = page
+
+		list, _, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.SenderSignatures, list.TotalCount, nil
+	})
+}
+
+// Get returns a single sender signature.
+func (s *SenderSignatureService) Get(ctx context.Context, id int64) (*SenderSignature, *http.Response, error) {
+	return s.do(ctx, "GET", fmt.Sprintf("senders/%d", id), nil)
+}
+
+// Create creates a new sender signature. A confirmation email is sent to the
+// From address.
+func (s *SenderSignatureService) Create(ctx context.Context, signature *SenderSignature) (*SenderSignature, *http.Response, error) {
+	return s.do(ctx, "POST", "senders", signature)
+}
+
+// Edit updates a sender signature.
+func (s *SenderSignatureService) Edit(ctx context.Context, id int64, signature *SenderSignature) (*SenderSignature, *http.Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("senders/%d", id), signature)
+}
+
+// Delete deletes a sender signature.
+func (s *SenderSignatureService) Delete(ctx context.Context, id int64) (*http.Response, error) {
+	return s.action(ctx, "DELETE", fmt.Sprintf("senders/%d", id))
+}
+
+// ResendConfirmation resends the confirmation email of an unconfirmed sender
+// signature.
+func (s *SenderSignatureService) ResendConfirmation(ctx context.Context, id int64) (*http.Response, error) {
+	return s.action(ctx, "POST", fmt.Sprintf("senders/%d/resend", id))
+}
+
+// RequestNewDKIM creates a new DKIM key for the domain of a sender
+// signature. The record of the new key is returned in the pending DKIM fields
+// of the signature until it is verified.
+func (s *SenderSignatureService) RequestNewDKIM(ctx context.Context, id int64) (*http.Response, error) {
+	return s.action(ctx, "POST", fmt.Sprintf("senders/%d/requestnewdkim", id))
+}
+
+// do sends a request to one of the endpoints that return a single sender
+// signature.
+func (s *SenderSignatureService) do(ctx context.Context, method, u string, body interface{}) (*SenderSignature, *http.Response, error) {
+	req, err := s.client.newAccountRequest(ctx, method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signature := new(SenderSignature)
+	resp, err := s.client.Do(req, signature)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return signature, resp, err
+}
+
+// action sends a request without a body to one of the endpoints that only
+// return a status message.
+func (s *SenderSignatureService) action(ctx context.Context, method, u string) (*http.Response, error) {
+	req, err := s.client.newAccountRequest(ctx, method, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,163 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+var _ = Describe("SenderSignature", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	Describe("Listing sender signatures", func() {
+		It("should return the signatures using the account token", func() {
+			env.Mux.HandleFunc("/senders", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				Expect(r.URL.Query().Get("offset")).To(Equal("50"))
+				env.assertAccountHeaders(r)
+				fmt.Fprintf(w, `{
+					"TotalCount": 1,
+					"SenderSignatures": [{
+						"Domain": "example.com",
+						"EmailAddress": "john.doe@example.com",
+						"ReplyToEmailAddress": "reply@example.com",
+						"Name": "John Doe",
+						"Confirmed": true,
+						"ID": 36735
+					}]
+				}`)
+			})
+
+			signatures, _, err := env.Client.SenderSignatures.List(context.Background(), &SenderSignatureListOptions{
+				ListOptions: ListOptions{Count: 50, Offset: 50},
+			})
+			Expect(err).To(BeNil())
+			Expect(signatures).To(Equal(&SenderSignatureList{
+				TotalCount: 1,
+				SenderSignatures: []SenderSignature{{
+					Domain:              String("example.com"),
+					EmailAddress:        String("john.doe@example.com"),
+					ReplyToEmailAddress: String("reply@example.com"),
+					Name:                String("John Doe"),
+					Confirmed:           Bool(true),
+					ID:                  Int64(36735),
+				}},
+			}))
+		})
+	})
+
+	Describe("Getting a sender signature", func() {
+		It("should return the signature", func() {
+			env.Mux.HandleFunc("/senders/36735", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				env.assertAccountHeaders(r)
+				fmt.Fprintf(w, `{
+					"ID": 36735,
+					"EmailAddress": "john.doe@example.com",
+					"DKIMHost": "jan2013pm._domainkey.example.com",
+					"ReturnPathDomainCNAMEValue": "pm.mtasv.net"
+				}`)
+			})
+
+			signature, _, err := env.Client.SenderSignatures.Get(context.Background(), 36735)
+			Expect(err).To(BeNil())
+			Expect(signature).To(Equal(&SenderSignature{
+				ID:                         Int64(36735),
+				EmailAddress:               String("john.doe@example.com"),
+				DKIMHost:                   String("jan2013pm._domainkey.example.com"),
+				ReturnPathDomainCNAMEValue: String("pm.mtasv.net"),
+			}))
+		})
+	})
+
+	Describe("Creating a sender signature", func() {
+		It("should post the signature", func() {
+			env.Mux.HandleFunc("/senders", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{
+					"FromEmail": "john.doe@example.com",
+					"Name": "John Doe",
+					"ConfirmationPersonalNote": "Please confirm"
+				}`))
+				fmt.Fprintf(w, `{ "ID": 1, "EmailAddress": "john.doe@example.com", "Confirmed": false }`)
+			})
+
+			signature, _, err := env.Client.SenderSignatures.Create(context.Background(), &SenderSignature{
+				FromEmail:                String("john.doe@example.com"),
+				Name:                     String("John Doe"),
+				ConfirmationPersonalNote: String("Please confirm"),
+			})
+			Expect(err).To(BeNil())
+			Expect(*signature.ID).To(Equal(int64(1)))
+		})
+	})
+
+	Describe("Editing a sender signature", func() {
+		It("should put the signature", func() {
+			env.Mux.HandleFunc("/senders/1", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PUT"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{ "ReplyToEmail": "reply@example.com" }`))
+				fmt.Fprintf(w, `{ "ID": 1, "ReplyToEmailAddress": "reply@example.com" }`)
+			})
+
+			signature, _, err := env.Client.SenderSignatures.Edit(context.Background(), 1, &SenderSignature{
+				ReplyToEmail: String("reply@example.com"),
+			})
+			Expect(err).To(BeNil())
+			Expect(*signature.ReplyToEmailAddress).To(Equal("reply@example.com"))
+		})
+	})
+
+	Describe("Deleting a sender signature", func() {
+		It("should send a delete request", func() {
+			env.Mux.HandleFunc("/senders/1", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("DELETE"))
+				env.assertAccountHeaders(r)
+				fmt.Fprintf(w, `{ "ErrorCode": 0, "Message": "Signature john.doe@example.com removed." }`)
+			})
+
+			_, err := env.Client.SenderSignatures.Delete(context.Background(), 1)
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Resending a confirmation", func() {
+		It("should post to the resend endpoint", func() {
+			env.Mux.HandleFunc("/senders/1/resend", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				fmt.Fprintf(w, `{ "ErrorCode": 0, "Message": "Confirmation email was re-sent." }`)
+			})
+
+			_, err := env.Client.SenderSignatures.ResendConfirmation(context.Background(), 1)
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Requesting a new DKIM key", func() {
+		It("should post to the request new DKIM endpoint", func() {
+			env.Mux.HandleFunc("/senders/1/requestnewdkim", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				fmt.Fprintf(w, `{ "ErrorCode": 0, "Message": "New DKIM key requested." }`)
+			})
+
+			_, err := env.Client.SenderSignatures.RequestNewDKIM(context.Background(), 1)
+			Expect(err).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,212 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// ServerService handles communication with the Servers related methods of
+// the Postmark API. These methods are authenticated with the account token.
+type ServerService struct {
+	client *Client
+}
+
+// CurrentServerService handles communication with the Server related methods
+// of the Postmark API, which operate on the server the server token belongs
+// to.
+type CurrentServerService struct {
+	client *Client
+}
+
+// Server represents a Postmark server along with its settings. The same type
+// is used to create and edit servers, in which case nil fields are left
+// unchanged.
+type Server struct {
+	ID         *int64   `json:"ID,omitempty"`
+	Name       *string  `json:"Name,omitempty"`
+	APITokens  []string `json:"ApiTokens,omitempty"`
+	ServerLink *string  `json:"ServerLink,omitempty"`
+
+	// Color is the color of the server in the web UI, such as "Blue" or
+	// "Green".
+	Color *string `json:"Color,omitempty"`
+
+	SMTPAPIActivated *bool `json:"SmtpApiActivated,omitempty"`
+	RawEmailEnabled  *bool `json:"RawEmailEnabled,omitempty"`
+
+	// DeliveryType is either "Live" or "Sandbox". It can only be set when
+	// creating a server.
+	DeliveryType *string `json:"DeliveryType,omitempty"`
+
+	InboundAddress       *string `json:"InboundAddress,omitempty"`
+	InboundDomain        *string `json:"InboundDomain,omitempty"`
+	InboundHash          *string `json:"InboundHash,omitempty"`
+	InboundSpamThreshold *int    `json:"InboundSpamThreshold,omitempty"`
+
+	// TrackOpens and TrackLinks set the default tracking for messages that
+	// don't specify it. TrackLinks is one of "None", "HtmlAndText",
+	// "HtmlOnly" or "TextOnly".
+	TrackOpens *bool   `json:"TrackOpens,omitempty"`
+	TrackLinks *string `json:"TrackLinks,omitempty"`
+
+	InboundHookURL             *string `json:"InboundHookUrl,omitempty"`
+	BounceHookURL              *string `json:"BounceHookUrl,omitempty"`
+	OpenHookURL                *string `json:"OpenHookUrl,omitempty"`
+	DeliveryHookURL            *string `json:"DeliveryHookUrl,omitempty"`
+	ClickHookURL               *string `json:"ClickHookUrl,omitempty"`
+	PostFirstOpenOnly          *bool   `json:"PostFirstOpenOnly,omitempty"`
+	IncludeBounceContentInHook *bool   `json:"IncludeBounceContentInHook,omitempty"`
+	EnableSMTPAPIErrorHooks    *bool   `json:"EnableSmtpApiErrorHooks,omitempty"`
+}
+
+// ServerList is a page of servers returned by ServerService.List.
+type ServerList struct {
+	TotalCount int
+	Servers    []Server
+}
+
+// ServerListOptions specifies the optional parameters to the
+// ServerService.List method.
+type ServerListOptions struct {
+	ListOptions
+
+	// Name filters servers by a full or partial name.
+	Name string `url:"name,omitempty"`
+}
+
+// List returns the servers of the account.
+func (s *ServerService) List(ctx context.Context, opt *ServerListOptions) (*ServerList, *http.Response, error) {
+	u, err := addOptions("servers", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newAccountRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	servers := new(ServerList)
+	resp, err := s.client.Do(req, servers)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return servers, resp, err
+}
+
+// ListAll returns an iterator over all the servers of the account, filtered
+// by the name of opt. See Paginate.
+func (s *ServerService) ListAll(ctx context.Context, opt *ServerListOptions) iter.Seq2[Server, error] {
+	var base ServerListOptions
+	if opt != nil {
+		base = *opt
+	}
+
+	return Paginate(ctx, &base.ListOptions, func(ctx context.Context, page ListOptions) ([]Server, int, error) {
+		o := base
+		o.ListOptions = page
+
+		list, _, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Servers, list.TotalCount, nil
+	})
+}
+
+// Get returns a single server.
+func (s *ServerService) Get(ctx context.Context, id int64) (*Server, *http.Response, error) {
+	u := fmt.Sprintf("servers/%d", id)
+	req, err := s.client.newAccountRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	server := new(Server)
+	resp, err := s.client.Do(req, server)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return server, resp, err
+}
+
+// Create creates a new server.
+func (s *ServerService) Create(ctx context.Context, server *Server) (*Server, *http.Response, error) {
+	req, err := s.client.newAccountRequest(ctx, "POST", "servers", server)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	srv := new(Server)
+	resp, err := s.client.Do(req, srv)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return srv, resp, err
+}
+
+// Edit updates the settings of a server.
+func (s *ServerService) Edit(ctx context.Context, id int64, server *Server) (*Server, *http.Response, error) {
+	u := fmt.Sprintf("servers/%d", id)
+	req, err := s.client.newAccountRequest(ctx, "PUT", u, server)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	srv := new(Server)
+	resp, err := s.client.Do(req, srv)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return srv, resp, err
+}
+
+// Delete deletes a server. Deleting servers must first be enabled for the
+// account by Postmark support.
+func (s *ServerService) Delete(ctx context.Context, id int64) (*http.Response, error) {
+	u := fmt.Sprintf("servers/%d", id)
+	req, err := s.client.newAccountRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Get returns the server the server token belongs to.
+func (s *CurrentServerService) Get(ctx context.Context) (*Server, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "GET", "server", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	server := new(Server)
+	resp, err := s.client.Do(req, server)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return server, resp, err
+}
+
+// Edit updates the settings of the server the server token belongs to.
+func (s *CurrentServerService) Edit(ctx context.Context, server *Server) (*Server, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "PUT", "server", server)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	srv := new(Server)
+	resp, err := s.client.Do(req, srv)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return srv, resp, err
+}
@@ -0,0 +1,163 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+var _ = Describe("Server", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	Describe("Listing servers", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"TotalCount": 1,
+					"Servers": [{
+						"ID": 1,
+						"Name": "Production01",
+						"ApiTokens": ["server-token"],
+						"Color": "red",
+						"InboundHookUrl": "https://hooks.example.com/inbound"
+					}]
+				}`)
+			})
+		})
+
+		It("should use the account token", func() {
+			_, resp, err := env.Client.Servers.List(context.Background(), &ServerListOptions{
+				ListOptions: ListOptions{Count: 50},
+				Name:        "Production",
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Request.Method).To(Equal("GET"))
+			Expect(resp.Request.URL.Query().Get("name")).To(Equal("Production"))
+			env.assertAccountHeaders(resp.Request)
+		})
+
+		It("should return the correct servers", func() {
+			servers, _, _ := env.Client.Servers.List(context.Background(), &ServerListOptions{})
+			Expect(servers).To(Equal(&ServerList{
+				TotalCount: 1,
+				Servers: []Server{{
+					ID:             Int64(1),
+					Name:           String("Production01"),
+					APITokens:      []string{"server-token"},
+					Color:          String("red"),
+					InboundHookURL: String("https://hooks.example.com/inbound"),
+				}},
+			}))
+		})
+	})
+
+	Describe("Getting a server", func() {
+		It("should return the server", func() {
+			env.Mux.HandleFunc("/servers/1", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				env.assertAccountHeaders(r)
+				fmt.Fprintf(w, `{ "ID": 1, "TrackOpens": true, "TrackLinks": "HtmlOnly" }`)
+			})
+
+			server, _, err := env.Client.Servers.Get(context.Background(), 1)
+			Expect(err).To(BeNil())
+			Expect(server).To(Equal(&Server{
+				ID:         Int64(1),
+				TrackOpens: Bool(true),
+				TrackLinks: String("HtmlOnly"),
+			}))
+		})
+	})
+
+	Describe("Creating a server", func() {
+		It("should post the server settings", func() {
+			env.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{ "Name": "Staging", "Color": "Blue", "DeliveryType": "Sandbox" }`))
+				fmt.Fprintf(w, `{ "ID": 2, "Name": "Staging" }`)
+			})
+
+			server, _, err := env.Client.Servers.Create(context.Background(), &Server{
+				Name:         String("Staging"),
+				Color:        String("Blue"),
+				DeliveryType: String("Sandbox"),
+			})
+			Expect(err).To(BeNil())
+			Expect(*server.ID).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("Editing a server", func() {
+		It("should put the changed settings", func() {
+			env.Mux.HandleFunc("/servers/2", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PUT"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{ "BounceHookUrl": "https://hooks.example.com/bounce" }`))
+				fmt.Fprintf(w, `{ "ID": 2 }`)
+			})
+
+			_, _, err := env.Client.Servers.Edit(context.Background(), 2, &Server{
+				BounceHookURL: String("https://hooks.example.com/bounce"),
+			})
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Deleting a server", func() {
+		It("should send a delete request", func() {
+			env.Mux.HandleFunc("/servers/2", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("DELETE"))
+				env.assertAccountHeaders(r)
+				fmt.Fprintf(w, `{ "ErrorCode": 0, "Message": "Server Staging removed." }`)
+			})
+
+			_, err := env.Client.Servers.Delete(context.Background(), 2)
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Getting the current server", func() {
+		It("should use the server token", func() {
+			env.Mux.HandleFunc("/server", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				env.assertServerHeaders(r)
+				fmt.Fprintf(w, `{ "ID": 1, "InboundDomain": "inbound.example.com" }`)
+			})
+
+			server, _, err := env.Client.Server.Get(context.Background())
+			Expect(err).To(BeNil())
+			Expect(*server.InboundDomain).To(Equal("inbound.example.com"))
+		})
+	})
+
+	Describe("Editing the current server", func() {
+		It("should put the changed settings", func() {
+			env.Mux.HandleFunc("/server", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PUT"))
+				env.assertServerHeaders(r)
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{ "PostFirstOpenOnly": false }`))
+				fmt.Fprintf(w, `{ "ID": 1, "PostFirstOpenOnly": false }`)
+			})
+
+			server, _, err := env.Client.Server.Edit(context.Background(), &Server{PostFirstOpenOnly: Bool(false)})
+			Expect(err).To(BeNil())
+			Expect(*server.PostFirstOpenOnly).To(BeFalse())
+		})
+	})
+})
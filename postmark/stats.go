@@ -0,0 +1,382 @@
+package postmark
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// StatsService handles communication with the Stats related
+// methods of the Postmark API.
+type StatsService struct {
+	client *Client
+}
+
+// StatsOptions specifies the optional parameters to the StatsService
+// methods.
+type StatsOptions struct {
+	Tag string `url:"tag,omitempty"`
+
+	// FromDate and ToDate filter statistics by date, in the form YYYY-MM-DD.
+	FromDate string `url:"fromdate,omitempty"`
+	ToDate   string `url:"todate,omitempty"`
+
+	MessageStream string `url:"messagestream,omitempty"`
+}
+
+// OutboundOverview is a summary of the outbound statistics of a server.
+type OutboundOverview struct {
+	Sent                  int
+	Bounced               int
+	SMTPAPIErrors         int `json:"SMTPApiErrors"`
+	BounceRate            float64
+	SpamComplaints        int
+	SpamComplaintsRate    float64
+	Opens                 int
+	UniqueOpens           int
+	Tracked               int
+	WithClientRecorded    int
+	WithPlatformRecorded  int
+	WithReadTimeRecorded  int
+	WithLinkTracking      int
+	WithOpenTracking      int
+	TotalClicks           int
+	UniqueLinksClicked    int
+	TotalTrackedLinksSent int
+}
+
+// SentCounts is the number of messages sent per day.
+type SentCounts struct {
+	Days []SentCountsDay
+	Sent int
+}
+
+// SentCountsDay is the number of messages sent on a single day.
+type SentCountsDay struct {
+	Date string
+	Sent int
+}
+
+// BounceCounts is the number of bounces by type per day.
+type BounceCounts struct {
+	Days         []BounceCountsDay
+	HardBounce   int
+	SoftBounce   int
+	SMTPAPIError int `json:"SMTPApiError"`
+	Transient    int
+}
+
+// BounceCountsDay is the number of bounces by type on a single day.
+type BounceCountsDay struct {
+	Date         string
+	HardBounce   int
+	SoftBounce   int
+	SMTPAPIError int `json:"SMTPApiError"`
+	Transient    int
+}
+
+// SpamComplaintCounts is the number of spam complaints per day.
+type SpamComplaintCounts struct {
+	Days          []SpamComplaintCountsDay
+	SpamComplaint int
+}
+
+// SpamComplaintCountsDay is the number of spam complaints on a single day.
+type SpamComplaintCountsDay struct {
+	Date          string
+	SpamComplaint int
+}
+
+// TrackedCounts is the number of messages sent with open or link tracking
+// per day.
+type TrackedCounts struct {
+	Days    []TrackedCountsDay
+	Tracked int
+}
+
+// TrackedCountsDay is the number of tracked messages sent on a single day.
+type TrackedCountsDay struct {
+	Date    string
+	Tracked int
+}
+
+// OpenCounts is the number of opens per day.
+type OpenCounts struct {
+	Days   []OpenCountsDay
+	Opens  int
+	Unique int
+}
+
+// OpenCountsDay is the number of opens on a single day.
+type OpenCountsDay struct {
+	Date   string
+	Opens  int
+	Unique int
+}
+
+// ClickCounts is the number of link clicks per day.
+type ClickCounts struct {
+	Days   []ClickCountsDay
+	Clicks int
+	Unique int
+}
+
+// ClickCountsDay is the number of link clicks on a single day.
+type ClickCountsDay struct {
+	Date   string
+	Clicks int
+	Unique int
+}
+
+// PlatformCounts is the number of opens or clicks by platform per day.
+type PlatformCounts struct {
+	Days    []PlatformCountsDay
+	Desktop int
+	Mobile  int
+	WebMail int
+	Unknown int
+}
+
+// PlatformCountsDay is the number of opens or clicks by platform on a single
+// day.
+type PlatformCountsDay struct {
+	Date    string
+	Desktop int
+	Mobile  int
+	WebMail int
+	Unknown int
+}
+
+// LocationCounts is the number of clicks by the part of the message the link
+// was in, per day.
+type LocationCounts struct {
+	Days []LocationCountsDay
+	HTML int
+	Text int
+}
+
+// LocationCountsDay is the number of clicks by location on a single day.
+type LocationCountsDay struct {
+	Date string
+	HTML int
+	Text int
+}
+
+// BreakdownCounts is the number of opens or clicks per day, broken down by a
+// property whose values are not known in advance, such as the email client or
+// browser family.
+type BreakdownCounts struct {
+	Days   []BreakdownCountsDay
+	Totals map[string]int
+}
+
+// BreakdownCountsDay is the number of opens or clicks on a single day, broken
+// down by a property whose values are not known in advance.
+type BreakdownCountsDay struct {
+	Date   string
+	Counts map[string]int
+}
+
+// UnmarshalJSON decodes the totals, which the Postmark API returns as
+// top-level keys next to the list of days.
+func (b *BreakdownCounts) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*b = BreakdownCounts{Totals: map[string]int{}}
+	for k, v := range raw {
+		if k == "Days" {
+			if err := json.Unmarshal(v, &b.Days); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var n int
+		if err := json.Unmarshal(v, &n); err != nil {
+			return err
+		}
+		b.Totals[k] = n
+	}
+
+	return nil
+}
+
+// UnmarshalJSON decodes the counts, which the Postmark API returns as keys
+// next to the date.
+func (d *BreakdownCountsDay) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*d = BreakdownCountsDay{Counts: map[string]int{}}
+	for k, v := range raw {
+		if k == "Date" {
+			if err := json.Unmarshal(v, &d.Date); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var n int
+		if err := json.Unmarshal(v, &n); err != nil {
+			return err
+		}
+		d.Counts[k] = n
+	}
+
+	return nil
+}
+
+// get fetches the statistics at path, filtered by opt, into v.
+func (s *StatsService) get(ctx context.Context, path string, opt *StatsOptions, v interface{}) (*http.Response, error) {
+	u, err := addOptions(path, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, v)
+}
+
+// GetOutboundOverview returns a summary of the outbound statistics.
+func (s *StatsService) GetOutboundOverview(ctx context.Context, opt *StatsOptions) (*OutboundOverview, *http.Response, error) {
+	overview := new(OutboundOverview)
+	resp, err := s.get(ctx, "stats/outbound", opt, overview)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return overview, resp, err
+}
+
+// GetSentCounts returns the number of messages sent per day.
+func (s *StatsService) GetSentCounts(ctx context.Context, opt *StatsOptions) (*SentCounts, *http.Response, error) {
+	counts := new(SentCounts)
+	resp, err := s.get(ctx, "stats/outbound/sends", opt, counts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return counts, resp, err
+}
+
+// GetBounceCounts returns the number of bounces by type per day.
+func (s *StatsService) GetBounceCounts(ctx context.Context, opt *StatsOptions) (*BounceCounts, *http.Response, error) {
+	counts := new(BounceCounts)
+	resp, err := s.get(ctx, "stats/outbound/bounces", opt, counts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return counts, resp, err
+}
+
+// GetSpamComplaints returns the number of spam complaints per day.
+func (s *StatsService) GetSpamComplaints(ctx context.Context, opt *StatsOptions) (*SpamComplaintCounts, *http.Response, error) {
+	counts := new(SpamComplaintCounts)
+	resp, err := s.get(ctx, "stats/outbound/spam", opt, counts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return counts, resp, err
+}
+
+// GetTrackedCounts returns the number of messages sent with tracking enabled
+// per day.
+func (s *StatsService) GetTrackedCounts(ctx context.Context, opt *StatsOptions) (*TrackedCounts, *http.Response, error) {
+	counts := new(TrackedCounts)
+	resp, err := s.get(ctx, "stats/outbound/tracked", opt, counts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return counts, resp, err
+}
+
+// GetOpenCounts returns the number of opens per day.
+func (s *StatsService) GetOpenCounts(ctx context.Context, opt *StatsOptions) (*OpenCounts, *http.Response, error) {
+	counts := new(OpenCounts)
+	resp, err := s.get(ctx, "stats/outbound/opens", opt, counts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return counts, resp, err
+}
+
+// GetOpenPlatforms returns the number of opens by platform per day.
+func (s *StatsService) GetOpenPlatforms(ctx context.Context, opt *StatsOptions) (*PlatformCounts, *http.Response, error) {
+	counts := new(PlatformCounts)
+	resp, err := s.get(ctx, "stats/outbound/opens/platforms", opt, counts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return counts, resp, err
+}
+
+// GetOpenEmailClients returns the number of opens by email client per day.
+func (s *StatsService) GetOpenEmailClients(ctx context.Context, opt *StatsOptions) (*BreakdownCounts, *http.Response, error) {
+	counts := new(BreakdownCounts)
+	resp, err := s.get(ctx, "stats/outbound/opens/emailclients", opt, counts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return counts, resp, err
+}
+
+// GetClickCounts returns the number of link clicks per day.
+func (s *StatsService) GetClickCounts(ctx context.Context, opt *StatsOptions) (*ClickCounts, *http.Response, error) {
+	counts := new(ClickCounts)
+	resp, err := s.get(ctx, "stats/outbound/clicks", opt, counts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return counts, resp, err
+}
+
+// GetClickBrowserFamilies returns the number of link clicks by browser
+// family per day.
+func (s *StatsService) GetClickBrowserFamilies(ctx context.Context, opt *StatsOptions) (*BreakdownCounts, *http.Response, error) {
+	counts := new(BreakdownCounts)
+	resp, err := s.get(ctx, "stats/outbound/clicks/browserfamilies", opt, counts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return counts, resp, err
+}
+
+// GetClickPlatforms returns the number of link clicks by platform per day.
+func (s *StatsService) GetClickPlatforms(ctx context.Context, opt *StatsOptions) (*PlatformCounts, *http.Response, error) {
+	counts := new(PlatformCounts)
+	resp, err := s.get(ctx, "stats/outbound/clicks/platforms", opt, counts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return counts, resp, err
+}
+
+// GetClickLocations returns the number of link clicks by location in the
+// message, HTML or text, per day.
+func (s *StatsService) GetClickLocations(ctx context.Context, opt *StatsOptions) (*LocationCounts, *http.Response, error) {
+	counts := new(LocationCounts)
+	resp, err := s.get(ctx, "stats/outbound/clicks/location", opt, counts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return counts, resp, err
+}
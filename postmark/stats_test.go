@@ -0,0 +1,172 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"fmt"
+	"net/http"
+)
+
+var _ = Describe("Stats", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	Describe("Getting the outbound overview", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/stats/outbound", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"Sent": 615,
+					"Bounced": 64,
+					"SMTPApiErrors": 25,
+					"BounceRate": 10.406,
+					"SpamComplaints": 10,
+					"SpamComplaintsRate": 1.626,
+					"Opens": 166,
+					"UniqueOpens": 26,
+					"Tracked": 111,
+					"TotalClicks": 72,
+					"UniqueLinksClicked": 30
+				}`)
+			})
+		})
+
+		It("should encode the filters as query parameters", func() {
+			_, resp, err := env.Client.Stats.GetOutboundOverview(context.Background(), &StatsOptions{
+				Tag:           "welcome",
+				FromDate:      "2014-01-01",
+				ToDate:        "2014-02-01",
+				MessageStream: "outbound",
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Request.Method).To(Equal("GET"))
+			env.assertServerHeaders(resp.Request)
+
+			q := resp.Request.URL.Query()
+			Expect(q.Get("tag")).To(Equal("welcome"))
+			Expect(q.Get("fromdate")).To(Equal("2014-01-01"))
+			Expect(q.Get("todate")).To(Equal("2014-02-01"))
+			Expect(q.Get("messagestream")).To(Equal("outbound"))
+		})
+
+		It("should return the correct overview", func() {
+			overview, _, _ := env.Client.Stats.GetOutboundOverview(context.Background(), nil)
+			Expect(overview).To(Equal(&OutboundOverview{
+				Sent:               615,
+				Bounced:            64,
+				SMTPAPIErrors:      25,
+				BounceRate:         10.406,
+				SpamComplaints:     10,
+				SpamComplaintsRate: 1.626,
+				Opens:              166,
+				UniqueOpens:        26,
+				Tracked:            111,
+				TotalClicks:        72,
+				UniqueLinksClicked: 30,
+			}))
+		})
+	})
+
+	Describe("Getting bounce counts", func() {
+		It("should return the daily time series", func() {
+			env.Mux.HandleFunc("/stats/outbound/bounces", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"Days": [
+						{ "Date": "2014-01-01", "HardBounce": 12, "SoftBounce": 36 },
+						{ "Date": "2014-01-03", "Transient": 7 }
+					],
+					"HardBounce": 12,
+					"SoftBounce": 36,
+					"Transient": 7
+				}`)
+			})
+
+			counts, _, err := env.Client.Stats.GetBounceCounts(context.Background(), nil)
+			Expect(err).To(BeNil())
+			Expect(counts).To(Equal(&BounceCounts{
+				Days: []BounceCountsDay{
+					{Date: "2014-01-01", HardBounce: 12, SoftBounce: 36},
+					{Date: "2014-01-03", Transient: 7},
+				},
+				HardBounce: 12,
+				SoftBounce: 36,
+				Transient:  7,
+			}))
+		})
+	})
+
+	Describe("Getting open platform counts", func() {
+		It("should return the daily time series", func() {
+			env.Mux.HandleFunc("/stats/outbound/opens/platforms", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"Days": [{ "Date": "2014-01-01", "Desktop": 1, "WebMail": 2 }],
+					"Desktop": 1,
+					"WebMail": 2
+				}`)
+			})
+
+			counts, _, err := env.Client.Stats.GetOpenPlatforms(context.Background(), nil)
+			Expect(err).To(BeNil())
+			Expect(counts).To(Equal(&PlatformCounts{
+				Days:    []PlatformCountsDay{{Date: "2014-01-01", Desktop: 1, WebMail: 2}},
+				Desktop: 1,
+				WebMail: 2,
+			}))
+		})
+	})
+
+	Describe("Getting open email client counts", func() {
+		It("should decode the clients into maps", func() {
+			env.Mux.HandleFunc("/stats/outbound/opens/emailclients", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"Days": [
+						{ "Date": "2014-01-01", "Outlook 2010": 1 },
+						{ "Date": "2014-01-02", "Gmail": 3, "Outlook 2010": 2 }
+					],
+					"Gmail": 3,
+					"Outlook 2010": 3
+				}`)
+			})
+
+			counts, _, err := env.Client.Stats.GetOpenEmailClients(context.Background(), nil)
+			Expect(err).To(BeNil())
+			Expect(counts).To(Equal(&BreakdownCounts{
+				Days: []BreakdownCountsDay{
+					{Date: "2014-01-01", Counts: map[string]int{"Outlook 2010": 1}},
+					{Date: "2014-01-02", Counts: map[string]int{"Gmail": 3, "Outlook 2010": 2}},
+				},
+				Totals: map[string]int{"Gmail": 3, "Outlook 2010": 3},
+			}))
+		})
+	})
+
+	Describe("Getting click location counts", func() {
+		It("should return the daily time series", func() {
+			env.Mux.HandleFunc("/stats/outbound/clicks/location", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"Days": [{ "Date": "2014-01-01", "HTML": 1, "Text": 2 }],
+					"HTML": 1,
+					"Text": 2
+				}`)
+			})
+
+			counts, _, err := env.Client.Stats.GetClickLocations(context.Background(), nil)
+			Expect(err).To(BeNil())
+			Expect(counts).To(Equal(&LocationCounts{
+				Days: []LocationCountsDay{{Date: "2014-01-01", HTML: 1, Text: 2}},
+				HTML: 1,
+				Text: 2,
+			}))
+		})
+	})
+})
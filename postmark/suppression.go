@@ -0,0 +1,139 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// SuppressionService handles communication with the Suppressions related
+// methods of the Postmark API.
+type SuppressionService struct {
+	client *Client
+}
+
+// Suppression is an email address that messages in a stream are not sent
+// to.
+type Suppression struct {
+	EmailAddress string
+
+	// SuppressionReason is why the address was suppressed, one of
+	// "HardBounce", "SpamComplaint" or "ManualSuppression".
+	SuppressionReason string
+
+	// Origin is who suppressed the address, one of "Recipient", "Customer"
+	// or "Admin".
+	Origin string
+
+	CreatedAt *time.Time
+}
+
+// SuppressionResult is the outcome of suppressing or reactivating a single
+// address.
+type SuppressionResult struct {
+	EmailAddress string
+
+	// Status is "Suppressed" or "Deleted" on success, and "Failed"
+	// otherwise, in which case Message explains why.
+	Status  string
+	Message string
+}
+
+// SuppressionListOptions specifies the optional parameters to the
+// SuppressionService.Dump method.
+type SuppressionListOptions struct {
+	SuppressionReason string `url:"SuppressionReason,omitempty"`
+	Origin            string `url:"Origin,omitempty"`
+
+	// FromDate and ToDate filter suppressions by date, in the form
+	// YYYY-MM-DD.
+	FromDate string `url:"fromdate,omitempty"`
+	ToDate   string `url:"todate,omitempty"`
+
+	EmailAddress string `url:"EmailAddress,omitempty"`
+}
+
+// suppressionEntry is a single address in a suppression request body.
+type suppressionEntry struct {
+	EmailAddress string
+}
+
+// suppressionRequest is the request body of the endpoints that create and
+// delete suppressions.
+type suppressionRequest struct {
+	Suppressions []suppressionEntry
+}
+
+// suppressionDump is the response body of the suppression dump endpoint.
+type suppressionDump struct {
+	Suppressions []Suppression
+}
+
+// suppressionResults is the response body of the endpoints that create and
+// delete suppressions.
+type suppressionResults struct {
+	Suppressions []SuppressionResult
+}
+
+// newSuppressionRequest builds the request body for emails.
+func newSuppressionRequest(emails []string) *suppressionRequest {
+	r := &suppressionRequest{Suppressions: make([]suppressionEntry, len(emails))}
+	for i, email := range emails {
+		r.Suppressions[i].EmailAddress = email
+	}
+	return r
+}
+
+// Dump returns the suppressed addresses of a message stream.
+func (s *SuppressionService) Dump(ctx context.Context, streamID string, opt *SuppressionListOptions) ([]Suppression, *http.Response, error) {
+	u, err := addOptions(fmt.Sprintf("message-streams/%s/suppressions/dump", streamID), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dump := new(suppressionDump)
+	resp, err := s.client.Do(req, dump)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return dump.Suppressions, resp, err
+}
+
+// Create suppresses emails in a message stream. The result for each address
+// is returned in the same order.
+func (s *SuppressionService) Create(ctx context.Context, streamID string, emails []string) ([]SuppressionResult, *http.Response, error) {
+	u := fmt.Sprintf("message-streams/%s/suppressions", streamID)
+	return s.update(ctx, u, emails)
+}
+
+// Delete reactivates suppressed emails in a message stream. Addresses
+// suppressed because of a spam complaint cannot be reactivated. The result
+// for each address is returned in the same order.
+func (s *SuppressionService) Delete(ctx context.Context, streamID string, emails []string) ([]SuppressionResult, *http.Response, error) {
+	u := fmt.Sprintf("message-streams/%s/suppressions/delete", streamID)
+	return s.update(ctx, u, emails)
+}
+
+// update posts emails to one of the endpoints that create or delete
+// suppressions.
+func (s *SuppressionService) update(ctx context.Context, u string, emails []string) ([]SuppressionResult, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "POST", u, newSuppressionRequest(emails))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := new(suppressionResults)
+	resp, err := s.client.Do(req, results)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return results.Suppressions, resp, err
+}
@@ -0,0 +1,115 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+var _ = Describe("Suppression", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	Describe("Dumping suppressions", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/message-streams/outbound/suppressions/dump", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"Suppressions": [{
+						"EmailAddress": "address@wildbit.com",
+						"SuppressionReason": "ManualSuppression",
+						"Origin": "Recipient"
+					}]
+				}`)
+			})
+		})
+
+		It("should encode the filters as query parameters", func() {
+			_, resp, err := env.Client.Suppressions.Dump(context.Background(), "outbound", &SuppressionListOptions{
+				SuppressionReason: "HardBounce",
+				Origin:            "Customer",
+				FromDate:          "2020-01-01",
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Request.Method).To(Equal("GET"))
+			env.assertServerHeaders(resp.Request)
+
+			q := resp.Request.URL.Query()
+			Expect(q.Get("SuppressionReason")).To(Equal("HardBounce"))
+			Expect(q.Get("Origin")).To(Equal("Customer"))
+			Expect(q.Get("fromdate")).To(Equal("2020-01-01"))
+		})
+
+		It("should return the suppressions", func() {
+			suppressions, _, _ := env.Client.Suppressions.Dump(context.Background(), "outbound", nil)
+			Expect(suppressions).To(Equal([]Suppression{{
+				EmailAddress:      "address@wildbit.com",
+				SuppressionReason: "ManualSuppression",
+				Origin:            "Recipient",
+			}}))
+		})
+	})
+
+	Describe("Creating suppressions", func() {
+		It("should post the addresses and return their statuses", func() {
+			env.Mux.HandleFunc("/message-streams/outbound/suppressions", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				env.assertServerHeaders(r)
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{
+					"Suppressions": [
+						{ "EmailAddress": "good.address@wildbit.com" },
+						{ "EmailAddress": "invalid-email-address" }
+					]
+				}`))
+				fmt.Fprintf(w, `{
+					"Suppressions": [
+						{ "EmailAddress": "good.address@wildbit.com", "Status": "Suppressed", "Message": null },
+						{ "EmailAddress": "invalid-email-address", "Status": "Failed", "Message": "An invalid email address was provided." }
+					]
+				}`)
+			})
+
+			results, _, err := env.Client.Suppressions.Create(context.Background(), "outbound", []string{
+				"good.address@wildbit.com",
+				"invalid-email-address",
+			})
+			Expect(err).To(BeNil())
+			Expect(results).To(Equal([]SuppressionResult{
+				{EmailAddress: "good.address@wildbit.com", Status: "Suppressed"},
+				{EmailAddress: "invalid-email-address", Status: "Failed", Message: "An invalid email address was provided."},
+			}))
+		})
+	})
+
+	Describe("Deleting suppressions", func() {
+		It("should post the addresses to the delete endpoint", func() {
+			env.Mux.HandleFunc("/message-streams/outbound/suppressions/delete", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				fmt.Fprintf(w, `{
+					"Suppressions": [
+						{ "EmailAddress": "good.address@wildbit.com", "Status": "Deleted", "Message": null }
+					]
+				}`)
+			})
+
+			results, _, err := env.Client.Suppressions.Delete(context.Background(), "outbound", []string{"good.address@wildbit.com"})
+			Expect(err).To(BeNil())
+			Expect(results).To(Equal([]SuppressionResult{
+				{EmailAddress: "good.address@wildbit.com", Status: "Deleted"},
+			}))
+		})
+	})
+})
@@ -0,0 +1,302 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// TemplateService handles communication with the Template related
+// methods of the Postmark API.
+type TemplateService struct {
+	client *Client
+}
+
+// TemplateType is the kind of a template. Layout templates hold content
+// shared by the standard templates that reference them, which is inserted at
+// their {{{@content}}} placeholder.
+type TemplateType string
+
+// The template types supported by the Postmark API.
+const (
+	TemplateTypeStandard TemplateType = "Standard"
+	TemplateTypeLayout   TemplateType = "Layout"
+)
+
+// Template represents a Postmark template. The same type is used to create
+// and edit templates, in which case nil fields are left unchanged.
+type Template struct {
+	TemplateID         *int64  `json:"TemplateId,omitempty"`
+	Name               *string `json:"Name,omitempty"`
+	Alias              *string `json:"Alias,omitempty"`
+	Subject            *string `json:"Subject,omitempty"`
+	HTMLBody           *string `json:"HtmlBody,omitempty"`
+	TextBody           *string `json:"TextBody,omitempty"`
+	AssociatedServerID *int64  `json:"AssociatedServerId,omitempty"`
+	Active             *bool   `json:"Active,omitempty"`
+
+	TemplateType *TemplateType `json:"TemplateType,omitempty"`
+
+	// LayoutTemplate is the alias of the layout used by a standard template.
+	LayoutTemplate *string `json:"LayoutTemplate,omitempty"`
+}
+
+// TemplateList is a page of templates returned by TemplateService.List.
+type TemplateList struct {
+	TotalCount int
+	Templates  []Template
+}
+
+// TemplateListOptions specifies the optional parameters to the
+// TemplateService.List method.
+type TemplateListOptions struct {
+	ListOptions
+
+	// TemplateType filters the templates by type. All types are returned
+	// when empty.
+	TemplateType TemplateType `url:"templateType,omitempty"`
+
+	// LayoutTemplate filters the templates that use the layout with this
+	// alias.
+	LayoutTemplate string `url:"layoutTemplate,omitempty"`
+}
+
+// TemplateModel holds the values used to render a template.
+type TemplateModel map[string]interface{}
+
+// TemplatedEmail is an email whose content is rendered from a template,
+// identified by either TemplateID or TemplateAlias. The Subject, HTMLBody and
+// TextBody fields of the embedded Email come from the template and should be
+// left nil.
+type TemplatedEmail struct {
+	TemplateID    *int64        `json:"TemplateId,omitempty"`
+	TemplateAlias *string       `json:"TemplateAlias,omitempty"`
+	TemplateModel TemplateModel `json:"TemplateModel"`
+	InlineCSS     *bool         `json:"InlineCss,omitempty"`
+
+	Email
+}
+
+// templatedBatch is the request body of the batch with templates endpoint.
+type templatedBatch struct {
+	Messages []TemplatedEmail
+}
+
+// TemplateValidation is the content to be validated by
+// TemplateService.Validate.
+type TemplateValidation struct {
+	Subject                    *string       `json:"Subject,omitempty"`
+	HTMLBody                   *string       `json:"HtmlBody,omitempty"`
+	TextBody                   *string       `json:"TextBody,omitempty"`
+	TestRenderModel            TemplateModel `json:"TestRenderModel,omitempty"`
+	InlineCSSForHTMLTestRender *bool         `json:"InlineCssForHtmlTestRender,omitempty"`
+
+	// TemplateType and LayoutTemplate validate the content as a layout, or
+	// render it within the layout with this alias.
+	TemplateType   *TemplateType `json:"TemplateType,omitempty"`
+	LayoutTemplate *string       `json:"LayoutTemplate,omitempty"`
+}
+
+// TemplateValidationResult reports whether each part of a template is valid
+// and how it renders with the test model.
+type TemplateValidationResult struct {
+	AllContentIsValid bool
+	HTMLBody          TemplateContentValidation `json:"HtmlBody"`
+	TextBody          TemplateContentValidation
+	Subject           TemplateContentValidation
+
+	// SuggestedTemplateModel is a model containing every variable referenced
+	// by the template.
+	SuggestedTemplateModel map[string]interface{}
+}
+
+// TemplateContentValidation is the validation result of a single part of a
+// template.
+type TemplateContentValidation struct {
+	ContentIsValid   bool
+	ValidationErrors []TemplateValidationError
+	RenderedContent  string
+}
+
+// TemplateValidationError describes a syntax error in a template.
+type TemplateValidationError struct {
+	Message           string
+	Line              int
+	CharacterPosition int
+}
+
+// TemplatePushRequest specifies the servers to push templates between.
+type TemplatePushRequest struct {
+	SourceServerID      int64 `json:"SourceServerID"`
+	DestinationServerID int64 `json:"DestinationServerID"`
+
+	// PerformChanges applies the changes when true. When false, the push is a
+	// dry run that only reports the changes that would be made.
+	PerformChanges bool `json:"PerformChanges"`
+}
+
+// TemplatePushAction is the change a push makes to a template on the
+// destination server.
+type TemplatePushAction string
+
+// The template push actions reported by the Postmark API.
+const (
+	TemplatePushCreate TemplatePushAction = "Create"
+	TemplatePushEdit   TemplatePushAction = "Edit"
+)
+
+// TemplatePushChange is a template that a push created or updated, or would
+// create or update in a dry run.
+type TemplatePushChange struct {
+	Action       TemplatePushAction
+	TemplateID   int64 `json:"TemplateId"`
+	Alias        string
+	Name         string
+	TemplateType TemplateType
+}
+
+// TemplatePushResult is the list of changes made by a template push.
+type TemplatePushResult struct {
+	TotalCount int
+	Templates  []TemplatePushChange
+}
+
+// List returns the templates of the server.
+func (s *TemplateService) List(ctx context.Context, opt *TemplateListOptions) (*TemplateList, *http.Response, error) {
+	u, err := addOptions("templates", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	templates := new(TemplateList)
+	resp, err := s.client.Do(req, templates)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return templates, resp, err
+}
+
+// ListAll returns an iterator over all the templates of the server matching
+// the type and layout filters of opt. See Paginate.
+func (s *TemplateService) ListAll(ctx context.Context, opt *TemplateListOptions) iter.Seq2[Template, error] {
+	var base TemplateListOptions
+	if opt != nil {
+		base = *opt
+	}
+
+	return Paginate(ctx, &base.ListOptions, func(ctx context.Context, page ListOptions) ([]Template, int, error) {
+		o := base
+		o.ListOptions = page
+
+		list, _, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Templates, list.TotalCount, nil
+	})
+}
+
+// Get returns a single template by its ID or alias.
+func (s *TemplateService) Get(ctx context.Context, idOrAlias string) (*Template, *http.Response, error) {
+	u := fmt.Sprintf("templates/%s", idOrAlias)
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := new(Template)
+	resp, err := s.client.Do(req, template)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return template, resp, err
+}
+
+// Create creates a new template. The returned template only contains the
+// identifying fields.
+func (s *TemplateService) Create(ctx context.Context, template *Template) (*Template, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "POST", "templates", template)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(Template)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
+
+// Edit updates the template identified by its ID or alias. The returned
+// template only contains the identifying fields.
+func (s *TemplateService) Edit(ctx context.Context, idOrAlias string, template *Template) (*Template, *http.Response, error) {
+	u := fmt.Sprintf("templates/%s", idOrAlias)
+	req, err := s.client.newServerRequest(ctx, "PUT", u, template)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(Template)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
+
+// Delete deletes the template identified by its ID or alias.
+func (s *TemplateService) Delete(ctx context.Context, idOrAlias string) (*http.Response, error) {
+	u := fmt.Sprintf("templates/%s", idOrAlias)
+	req, err := s.client.newServerRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Validate checks the syntax of template content and renders it with the
+// test model.
+func (s *TemplateService) Validate(ctx context.Context, validation *TemplateValidation) (*TemplateValidationResult, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "POST", "templates/validate", validation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(TemplateValidationResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, err
+}
+
+// Push copies the templates with an alias from the source server to the
+// destination server, creating or updating the templates with the same alias.
+// It is authenticated with the account token.
+func (s *TemplateService) Push(ctx context.Context, push *TemplatePushRequest) (*TemplatePushResult, *http.Response, error) {
+	req, err := s.client.newAccountRequest(ctx, "PUT", "templates/push", push)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(TemplatePushResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, err
+}
@@ -0,0 +1,293 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+var _ = Describe("Template", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	Describe("Listing templates", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"TotalCount": 1,
+					"Templates": [{
+						"Active": true,
+						"TemplateId": 1234,
+						"Name": "Account Activation Email",
+						"Alias": "code-activation"
+					}]
+				}`)
+			})
+		})
+
+		It("should get the /templates endpoint with pagination", func() {
+			_, resp, err := env.Client.Templates.List(context.Background(), &TemplateListOptions{
+				ListOptions: ListOptions{Count: 100},
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Request.Method).To(Equal("GET"))
+			Expect(resp.Request.URL.Path).To(Equal("/templates"))
+			Expect(resp.Request.URL.Query().Get("count")).To(Equal("100"))
+			env.assertServerHeaders(resp.Request)
+		})
+
+		It("should filter the templates by type and layout", func() {
+			_, resp, err := env.Client.Templates.List(context.Background(), &TemplateListOptions{
+				TemplateType:   TemplateTypeStandard,
+				LayoutTemplate: "basic-layout",
+			})
+			Expect(err).To(BeNil())
+			q := resp.Request.URL.Query()
+			Expect(q.Get("templateType")).To(Equal("Standard"))
+			Expect(q.Get("layoutTemplate")).To(Equal("basic-layout"))
+		})
+
+		It("should not send empty filters", func() {
+			_, resp, err := env.Client.Templates.List(context.Background(), &TemplateListOptions{})
+			Expect(err).To(BeNil())
+			Expect(resp.Request.URL.Query()).NotTo(HaveKey("templateType"))
+			Expect(resp.Request.URL.Query()).NotTo(HaveKey("layoutTemplate"))
+		})
+
+		It("should return the correct templates", func() {
+			templates, _, _ := env.Client.Templates.List(context.Background(), &TemplateListOptions{})
+			Expect(templates).To(Equal(&TemplateList{
+				TotalCount: 1,
+				Templates: []Template{{
+					Active:     Bool(true),
+					TemplateID: Int64(1234),
+					Name:       String("Account Activation Email"),
+					Alias:      String("code-activation"),
+				}},
+			}))
+		})
+	})
+
+	Describe("Getting a template", func() {
+		It("should get the template by alias", func() {
+			env.Mux.HandleFunc("/templates/code-activation", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				fmt.Fprintf(w, `{
+					"TemplateId": 1234,
+					"Alias": "code-activation",
+					"Subject": "{{product_name}} activation",
+					"HtmlBody": "<p>Hi {{name}}</p>",
+					"TemplateType": "Standard",
+					"LayoutTemplate": "basic-layout"
+				}`)
+			})
+
+			template, _, err := env.Client.Templates.Get(context.Background(), "code-activation")
+			Expect(err).To(BeNil())
+			templateType := TemplateTypeStandard
+			Expect(template).To(Equal(&Template{
+				TemplateID: Int64(1234),
+				Alias:      String("code-activation"),
+				Subject:    String("{{product_name}} activation"),
+				HTMLBody:   String("<p>Hi {{name}}</p>"),
+
+				TemplateType:   &templateType,
+				LayoutTemplate: String("basic-layout"),
+			}))
+		})
+	})
+
+	Describe("Creating a template", func() {
+		It("should post a layout template", func() {
+			env.Mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{
+					"Name": "Basic layout",
+					"Alias": "basic-layout",
+					"HtmlBody": "<header/>{{{@content}}}<footer/>",
+					"TemplateType": "Layout"
+				}`))
+				fmt.Fprintf(w, `{ "TemplateId": 1235, "TemplateType": "Layout" }`)
+			})
+
+			templateType := TemplateTypeLayout
+			template, _, err := env.Client.Templates.Create(context.Background(), &Template{
+				Name:         String("Basic layout"),
+				Alias:        String("basic-layout"),
+				HTMLBody:     String("<header/>{{{@content}}}<footer/>"),
+				TemplateType: &templateType,
+			})
+			Expect(err).To(BeNil())
+			Expect(*template.TemplateType).To(Equal(TemplateTypeLayout))
+		})
+
+		It("should post the template and return its identifiers", func() {
+			env.Mux.HandleFunc("/templates", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{ "Name": "Welcome", "Subject": "Hi" }`))
+				fmt.Fprintf(w, `{ "TemplateId": 1234, "Name": "Welcome", "Active": true }`)
+			})
+
+			template, _, err := env.Client.Templates.Create(context.Background(), &Template{
+				Name:    String("Welcome"),
+				Subject: String("Hi"),
+			})
+			Expect(err).To(BeNil())
+			Expect(*template.TemplateID).To(Equal(int64(1234)))
+		})
+	})
+
+	Describe("Editing a template", func() {
+		It("should put the template to its endpoint", func() {
+			env.Mux.HandleFunc("/templates/1234", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PUT"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{ "Subject": "Hello" }`))
+				fmt.Fprintf(w, `{ "TemplateId": 1234 }`)
+			})
+
+			_, _, err := env.Client.Templates.Edit(context.Background(), "1234", &Template{Subject: String("Hello")})
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Deleting a template", func() {
+		It("should send a delete request", func() {
+			env.Mux.HandleFunc("/templates/1234", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("DELETE"))
+				env.assertServerHeaders(r)
+				fmt.Fprintf(w, `{ "ErrorCode": 0, "Message": "Template 1234 removed." }`)
+			})
+
+			_, err := env.Client.Templates.Delete(context.Background(), "1234")
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Validating a template", func() {
+		It("should return the validation result", func() {
+			env.Mux.HandleFunc("/templates/validate", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{
+					"Subject": "{{#company}}{{name}}{{/company}}",
+					"TestRenderModel": { "company": { "name": "Acme" } }
+				}`))
+				fmt.Fprintf(w, `{
+					"AllContentIsValid": false,
+					"Subject": {
+						"ContentIsValid": true,
+						"ValidationErrors": [],
+						"RenderedContent": "Acme"
+					},
+					"HtmlBody": {
+						"ContentIsValid": false,
+						"ValidationErrors": [{
+							"Message": "The syntax for this template is invalid.",
+							"Line": 1,
+							"CharacterPosition": 3
+						}]
+					},
+					"SuggestedTemplateModel": { "company": { "name": "name_Value" } }
+				}`)
+			})
+
+			result, _, err := env.Client.Templates.Validate(context.Background(), &TemplateValidation{
+				Subject: String("{{#company}}{{name}}{{/company}}"),
+				TestRenderModel: TemplateModel{
+					"company": map[string]string{"name": "Acme"},
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(result.AllContentIsValid).To(BeFalse())
+			Expect(result.Subject.RenderedContent).To(Equal("Acme"))
+			Expect(result.HTMLBody.ValidationErrors).To(Equal([]TemplateValidationError{{
+				Message:           "The syntax for this template is invalid.",
+				Line:              1,
+				CharacterPosition: 3,
+			}}))
+			Expect(result.SuggestedTemplateModel).To(HaveKey("company"))
+		})
+	})
+
+	Describe("Validating a template within a layout", func() {
+		It("should send the layout alias", func() {
+			env.Mux.HandleFunc("/templates/validate", func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{
+					"HtmlBody": "<p>Hi</p>",
+					"TemplateType": "Standard",
+					"LayoutTemplate": "basic-layout"
+				}`))
+				fmt.Fprintf(w, `{
+					"AllContentIsValid": true,
+					"HtmlBody": {
+						"ContentIsValid": true,
+						"ValidationErrors": [],
+						"RenderedContent": "<header/><p>Hi</p><footer/>"
+					}
+				}`)
+			})
+
+			templateType := TemplateTypeStandard
+			result, _, err := env.Client.Templates.Validate(context.Background(), &TemplateValidation{
+				HTMLBody:       String("<p>Hi</p>"),
+				TemplateType:   &templateType,
+				LayoutTemplate: String("basic-layout"),
+			})
+			Expect(err).To(BeNil())
+			Expect(result.HTMLBody.RenderedContent).To(Equal("<header/><p>Hi</p><footer/>"))
+		})
+	})
+
+	Describe("Pushing templates", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/templates/push", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PUT"))
+				env.assertAccountHeaders(r)
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{
+					"SourceServerID": 997881,
+					"DestinationServerID": 997882,
+					"PerformChanges": false
+				}`))
+				fmt.Fprintf(w, `{
+					"TotalCount": 2,
+					"Templates": [
+						{ "Action": "Create", "TemplateId": null, "Alias": "welcome", "Name": "Welcome", "TemplateType": "Standard" },
+						{ "Action": "Edit", "TemplateId": 7270, "Alias": "receipt", "Name": "Receipt" }
+					]
+				}`)
+			})
+		})
+
+		It("should return the changes of a dry run", func() {
+			result, _, err := env.Client.Templates.Push(context.Background(), &TemplatePushRequest{
+				SourceServerID:      997881,
+				DestinationServerID: 997882,
+			})
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(&TemplatePushResult{
+				TotalCount: 2,
+				Templates: []TemplatePushChange{
+					{Action: TemplatePushCreate, Alias: "welcome", Name: "Welcome", TemplateType: TemplateTypeStandard},
+					{Action: TemplatePushEdit, TemplateID: 7270, Alias: "receipt", Name: "Receipt"},
+				},
+			}))
+		})
+	})
+})
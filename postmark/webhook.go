@@ -0,0 +1,159 @@
+package postmark
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// WebhookService handles communication with the Webhooks related
+// methods of the Postmark API.
+type WebhookService struct {
+	client *Client
+}
+
+// Webhook represents a URL that Postmark posts events to. The same type is
+// used to create and edit webhooks, in which case nil fields are left
+// unchanged. The MessageStream can only be set when creating a webhook.
+type Webhook struct {
+	ID            *int64           `json:"ID,omitempty"`
+	URL           *string          `json:"Url,omitempty"`
+	MessageStream *string          `json:"MessageStream,omitempty"`
+	HTTPAuth      *WebhookHTTPAuth `json:"HttpAuth,omitempty"`
+	HTTPHeaders   []Header         `json:"HttpHeaders,omitempty"`
+	Triggers      *WebhookTriggers `json:"Triggers,omitempty"`
+}
+
+// WebhookHTTPAuth holds the HTTP basic auth credentials Postmark uses when
+// posting to a webhook.
+type WebhookHTTPAuth struct {
+	Username *string `json:"Username,omitempty"`
+	Password *string `json:"Password,omitempty"`
+}
+
+// WebhookTriggers holds the settings of each event that can be posted to a
+// webhook.
+type WebhookTriggers struct {
+	Open               *WebhookOpenTrigger    `json:"Open,omitempty"`
+	Click              *WebhookTrigger        `json:"Click,omitempty"`
+	Delivery           *WebhookTrigger        `json:"Delivery,omitempty"`
+	Bounce             *WebhookContentTrigger `json:"Bounce,omitempty"`
+	SpamComplaint      *WebhookContentTrigger `json:"SpamComplaint,omitempty"`
+	SubscriptionChange *WebhookTrigger        `json:"SubscriptionChange,omitempty"`
+}
+
+// WebhookTrigger enables or disables posting an event to a webhook.
+type WebhookTrigger struct {
+	Enabled *bool `json:"Enabled,omitempty"`
+}
+
+// WebhookOpenTrigger holds the settings of the open event.
+type WebhookOpenTrigger struct {
+	Enabled *bool `json:"Enabled,omitempty"`
+
+	// PostFirstOpenOnly limits the posted events to the first open of each
+	// message.
+	PostFirstOpenOnly *bool `json:"PostFirstOpenOnly,omitempty"`
+}
+
+// WebhookContentTrigger holds the settings of events that can include the
+// content of the message that caused them.
+type WebhookContentTrigger struct {
+	Enabled        *bool `json:"Enabled,omitempty"`
+	IncludeContent *bool `json:"IncludeContent,omitempty"`
+}
+
+// WebhookListOptions specifies the optional parameters to the
+// WebhookService.List method.
+type WebhookListOptions struct {
+	// MessageStream filters webhooks by message stream. Webhooks of all
+	// streams are returned when empty.
+	MessageStream string `url:"MessageStream,omitempty"`
+}
+
+// webhookList is the response body of the webhook list endpoint.
+type webhookList struct {
+	Webhooks []Webhook
+}
+
+// List returns the webhooks of the server.
+func (s *WebhookService) List(ctx context.Context, opt *WebhookListOptions) ([]Webhook, *http.Response, error) {
+	u, err := addOptions("webhooks", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhooks := new(webhookList)
+	resp, err := s.client.Do(req, webhooks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return webhooks.Webhooks, resp, err
+}
+
+// Get returns a single webhook.
+func (s *WebhookService) Get(ctx context.Context, id int64) (*Webhook, *http.Response, error) {
+	u := fmt.Sprintf("webhooks/%d", id)
+	req, err := s.client.newServerRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := new(Webhook)
+	resp, err := s.client.Do(req, webhook)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return webhook, resp, err
+}
+
+// Create creates a new webhook.
+func (s *WebhookService) Create(ctx context.Context, webhook *Webhook) (*Webhook, *http.Response, error) {
+	req, err := s.client.newServerRequest(ctx, "POST", "webhooks", webhook)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w := new(Webhook)
+	resp, err := s.client.Do(req, w)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return w, resp, err
+}
+
+// Edit updates a webhook.
+func (s *WebhookService) Edit(ctx context.Context, id int64, webhook *Webhook) (*Webhook, *http.Response, error) {
+	u := fmt.Sprintf("webhooks/%d", id)
+	req, err := s.client.newServerRequest(ctx, "PUT", u, webhook)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w := new(Webhook)
+	resp, err := s.client.Do(req, w)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return w, resp, err
+}
+
+// Delete deletes a webhook.
+func (s *WebhookService) Delete(ctx context.Context, id int64) (*http.Response, error) {
+	u := fmt.Sprintf("webhooks/%d", id)
+	req, err := s.client.newServerRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
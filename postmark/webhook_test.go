@@ -0,0 +1,142 @@
+package postmark_test
+
+import (
+	. "github.com/hudl/go-postmark/v2/postmark"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+var _ = Describe("Webhook", func() {
+	var env *testEnv
+
+	BeforeEach(func() {
+		env = newTestEnv()
+	})
+
+	AfterEach(func() {
+		env.StopServer()
+	})
+
+	Describe("Listing webhooks", func() {
+		BeforeEach(func() {
+			env.Mux.HandleFunc("/webhooks", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{
+					"Webhooks": [{
+						"ID": 1234567,
+						"Url": "https://www.example.com/webhook",
+						"MessageStream": "outbound",
+						"HttpAuth": { "Username": "user", "Password": "pass" },
+						"HttpHeaders": [{ "Name": "name", "Value": "value" }],
+						"Triggers": {
+							"Open": { "Enabled": true, "PostFirstOpenOnly": false },
+							"Click": { "Enabled": true },
+							"Bounce": { "Enabled": false, "IncludeContent": false }
+						}
+					}]
+				}`)
+			})
+		})
+
+		It("should filter by message stream", func() {
+			_, resp, err := env.Client.Webhooks.List(context.Background(), &WebhookListOptions{MessageStream: "outbound"})
+			Expect(err).To(BeNil())
+			Expect(resp.Request.Method).To(Equal("GET"))
+			Expect(resp.Request.URL.Query().Get("MessageStream")).To(Equal("outbound"))
+			env.assertServerHeaders(resp.Request)
+		})
+
+		It("should return the correct webhooks", func() {
+			webhooks, _, _ := env.Client.Webhooks.List(context.Background(), nil)
+			Expect(webhooks).To(Equal([]Webhook{{
+				ID:            Int64(1234567),
+				URL:           String("https://www.example.com/webhook"),
+				MessageStream: String("outbound"),
+				HTTPAuth: &WebhookHTTPAuth{
+					Username: String("user"),
+					Password: String("pass"),
+				},
+				HTTPHeaders: []Header{{Name: String("name"), Value: String("value")}},
+				Triggers: &WebhookTriggers{
+					Open:   &WebhookOpenTrigger{Enabled: Bool(true), PostFirstOpenOnly: Bool(false)},
+					Click:  &WebhookTrigger{Enabled: Bool(true)},
+					Bounce: &WebhookContentTrigger{Enabled: Bool(false), IncludeContent: Bool(false)},
+				},
+			}}))
+		})
+	})
+
+	Describe("Getting a webhook", func() {
+		It("should return the webhook", func() {
+			env.Mux.HandleFunc("/webhooks/1234567", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("GET"))
+				fmt.Fprintf(w, `{ "ID": 1234567, "Url": "https://www.example.com/webhook" }`)
+			})
+
+			webhook, _, err := env.Client.Webhooks.Get(context.Background(), 1234567)
+			Expect(err).To(BeNil())
+			Expect(*webhook.URL).To(Equal("https://www.example.com/webhook"))
+		})
+	})
+
+	Describe("Creating a webhook", func() {
+		It("should post the webhook", func() {
+			env.Mux.HandleFunc("/webhooks", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("POST"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{
+					"Url": "https://www.example.com/webhook",
+					"Triggers": {
+						"SpamComplaint": { "Enabled": true, "IncludeContent": true },
+						"SubscriptionChange": { "Enabled": true }
+					}
+				}`))
+				fmt.Fprintf(w, `{ "ID": 1234567 }`)
+			})
+
+			webhook, _, err := env.Client.Webhooks.Create(context.Background(), &Webhook{
+				URL: String("https://www.example.com/webhook"),
+				Triggers: &WebhookTriggers{
+					SpamComplaint:      &WebhookContentTrigger{Enabled: Bool(true), IncludeContent: Bool(true)},
+					SubscriptionChange: &WebhookTrigger{Enabled: Bool(true)},
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(*webhook.ID).To(Equal(int64(1234567)))
+		})
+	})
+
+	Describe("Editing a webhook", func() {
+		It("should put the webhook", func() {
+			env.Mux.HandleFunc("/webhooks/1234567", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("PUT"))
+				body, _ := ioutil.ReadAll(r.Body)
+				Expect(body).To(MatchJSON(`{ "Triggers": { "Delivery": { "Enabled": false } } }`))
+				fmt.Fprintf(w, `{ "ID": 1234567 }`)
+			})
+
+			_, _, err := env.Client.Webhooks.Edit(context.Background(), 1234567, &Webhook{
+				Triggers: &WebhookTriggers{Delivery: &WebhookTrigger{Enabled: Bool(false)}},
+			})
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Deleting a webhook", func() {
+		It("should send a delete request", func() {
+			env.Mux.HandleFunc("/webhooks/1234567", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal("DELETE"))
+				env.assertServerHeaders(r)
+				fmt.Fprintf(w, `{ "ErrorCode": 0, "Message": "Webhook 1234567 removed." }`)
+			})
+
+			_, err := env.Client.Webhooks.Delete(context.Background(), 1234567)
+			Expect(err).To(BeNil())
+		})
+	})
+})